@@ -0,0 +1,283 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"regexp"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/ugorji/go/codec"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"nhooyr.io/websocket"
+)
+
+func (c *connection) ownedFeedIDs(ctx context.Context) ([]primitive.ObjectID, error) {
+	var feeds []structures.Feed
+	cursor, err := c.a.feeds.Find(ctx, bson.M{
+		"owner_id": c.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &feeds)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]primitive.ObjectID, 0, len(feeds))
+	for _, f := range feeds {
+		ids = append(ids, f.ID)
+	}
+	return ids, nil
+}
+
+// handleDeleteAccount is two-step: an empty signature gets a fresh challenge back, the following request has to carry a signature over it
+// It's a raw route since the connection has to be closed after the response went out
+func (c *connection) handleDeleteAccount(mi *MessageInfo, buf []byte) {
+	var req structures.DeleteAccountRequest
+	ok := c.decodeToInterface(buf, &req)
+	if !ok {
+		return
+	}
+
+	if len(req.Signature) == 0 {
+		randBuf := make([]byte, 32)
+		_, err := io.ReadFull(rand.Reader, randBuf)
+		if err != nil {
+			c.writeError(mi, err)
+			return
+		}
+		challenge := []byte(c.localizer.MustLocalize(&i18n.LocalizeConfig{
+			MessageID: "General.DeletionChallengeMessage",
+			TemplateData: map[string]string{
+				"Data": base64.StdEncoding.EncodeToString(randBuf),
+			},
+		}))
+
+		c.challengeMu.Lock()
+		c.deletionChallenge = challenge
+		c.challengeMu.Unlock()
+
+		c.writeMessage(true, mi, structures.DeleteAccountResponse{
+			Deleted:   false,
+			Challenge: challenge,
+		})
+		return
+	}
+
+	// A challenge can only be used once, regardless of the outcome
+	c.challengeMu.Lock()
+	challenge := c.deletionChallenge
+	c.deletionChallenge = nil
+	c.challengeMu.Unlock()
+
+	if challenge == nil {
+		c.writeError(mi, newRequestError(structures.ErrorInvalidInputs, "Errors.NoPendingChallenge", nil))
+		return
+	}
+
+	ok, err := c.a.sigVerifier.Verify(c.ctx, c.addr, challenge, req.Signature)
+	if !ok {
+		c.writeError(mi, invalidSignatureError(err))
+		return
+	}
+
+	feedIDs, err := c.ownedFeedIDs(c.ctx)
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+
+	// Dependents go first so that a failure midway never leaves orphans behind
+	_, err = c.a.seenItems.DeleteMany(c.ctx, bson.M{
+		"feed_id": bson.M{"$in": feedIDs},
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	_, err = c.a.items.DeleteMany(c.ctx, bson.M{
+		"owner_id": c.userID,
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	_, err = c.a.feeds.DeleteMany(c.ctx, bson.M{
+		"owner_id": c.userID,
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	_, err = c.a.sessions.DeleteMany(c.ctx, bson.M{
+		"user_id": c.userID,
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	_, err = c.a.apiKeys.DeleteMany(c.ctx, bson.M{
+		"user_id": c.userID,
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	_, err = c.a.eventLog.DeleteMany(c.ctx, bson.M{
+		"user_id": c.userID,
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	// Buckets are keyed by subject and request name, the IP's are left to refill as they're shared
+	_, err = c.a.buckets.DeleteMany(c.ctx, bson.M{
+		"_id": bson.M{"$regex": "^" + regexp.QuoteMeta(userSubject(c.userID)+":")},
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	_, err = c.a.users.DeleteOne(c.ctx, bson.M{
+		"_id": c.userID,
+	})
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	log.Printf("Deleted account %s along with %d feeds\n", hex.EncodeToString(c.userID[:]), len(feedIDs))
+
+	c.writeMessage(true, mi, structures.DeleteAccountResponse{
+		Deleted: true,
+	})
+	c.conn.Close(websocket.StatusNormalClosure, "account deleted")
+}
+
+// writeJSONToZip goes through the codec like the API does, so that the export has the same field names and leaves out
+// the same secrets
+func (a *app) writeJSONToZip(zw *zip.Writer, name string, data interface{}) error {
+	var raw []byte
+	err := codec.NewEncoderBytes(&raw, a.jsonHandle).Encode(data)
+	if err != nil {
+		return err
+	}
+	indented := new(bytes.Buffer)
+	err = json.Indent(indented, raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	indented.WriteByte('\n')
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(indented.Bytes())
+	return err
+}
+
+func (c *connection) handleExportData(ctx context.Context) (*structures.ExportDataResponse, error) {
+	u, err := c.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Not data about the user, just a secret
+	u.EmailVerificationToken = [32]byte{}
+
+	var feeds []structures.Feed
+	cursor, err := c.a.feeds.Find(ctx, bson.M{
+		"owner_id": c.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &feeds)
+	if err != nil {
+		return nil, err
+	}
+	if feeds == nil {
+		feeds = []structures.Feed{}
+	}
+
+	feedIDs := make([]primitive.ObjectID, 0, len(feeds))
+	for _, f := range feeds {
+		feedIDs = append(feedIDs, f.ID)
+	}
+	var seenItems []structures.SeenItem
+	cursor, err = c.a.seenItems.Find(ctx, bson.M{
+		"feed_id": bson.M{"$in": feedIDs},
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &seenItems)
+	if err != nil {
+		return nil, err
+	}
+	if seenItems == nil {
+		seenItems = []structures.SeenItem{}
+	}
+
+	var items []structures.Item
+	cursor, err = c.a.items.Find(ctx, bson.M{
+		"owner_id": c.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &items)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []structures.Item{}
+	}
+
+	var apiKeys []structures.APIKey
+	cursor, err = c.a.apiKeys.Find(ctx, bson.M{
+		"user_id": c.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &apiKeys)
+	if err != nil {
+		return nil, err
+	}
+	if apiKeys == nil {
+		apiKeys = []structures.APIKey{}
+	}
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for name, data := range map[string]interface{}{
+		"user.json":       u,
+		"feeds.json":      feeds,
+		"seen_items.json": seenItems,
+		"items.json":      items,
+		"api_keys.json":   apiKeys,
+	} {
+		err = c.a.writeJSONToZip(zw, name, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = zw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return &structures.ExportDataResponse{
+		FileName: "rss2email-export-" + time.Now().UTC().Format("2006-01-02") + ".zip",
+		Archive:  buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/ugorji/go/codec"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// sessionLifetime is how long a bearer token issued over the websocket stays valid
+const sessionLifetime = 30 * 24 * time.Hour
+
+func hashToken(token string) [32]byte {
+	return sha256.Sum256([]byte(token))
+}
+
+func (c *connection) handleIssueSessionToken(ctx context.Context) (*structures.SessionTokenResponse, error) {
+	randBuf := make([]byte, 32)
+	_, err := io.ReadFull(rand.Reader, randBuf)
+	if err != nil {
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(randBuf)
+
+	session := structures.Session{
+		ID:        primitive.NewObjectID(),
+		UserID:    c.userID,
+		TokenHash: hashToken(token),
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(sessionLifetime),
+		// A session can't do more than the connection that asked for it
+		Scopes: c.scopes,
+		KeyID:  c.apiKeyID,
+	}
+	_, err = c.a.sessions.InsertOne(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	return &structures.SessionTokenResponse{
+		Token:     token,
+		ExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+// authenticateBearer accepts both session tokens and API keys, scopes is nil for sessions issued to signature logins
+func (a *app) authenticateBearer(ctx context.Context, r *http.Request) (userID primitive.ObjectID, scopes []string, err error) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || len(token) == 0 {
+		return primitive.NilObjectID, nil, errUnauthorized
+	}
+	return a.authenticateToken(ctx, token)
+}
+
+func (a *app) authenticateToken(ctx context.Context, token string) (userID primitive.ObjectID, scopes []string, err error) {
+
+	if strings.HasPrefix(token, apiKeyPrefix) {
+		key, err := a.lookupAPIKey(ctx, token)
+		if err != nil {
+			return primitive.NilObjectID, nil, err
+		}
+		return key.UserID, key.Scopes, nil
+	}
+
+	var session structures.Session
+	err = a.sessions.FindOne(ctx, bson.M{
+		"token_hash": hashToken(token),
+		"expires_at": bson.M{"$gt": time.Now()},
+	}).Decode(&session)
+	if err == mongo.ErrNoDocuments {
+		return primitive.NilObjectID, nil, errUnauthorized
+	}
+	if err != nil {
+		return primitive.NilObjectID, nil, err
+	}
+	return session.UserID, session.Scopes, nil
+}
+
+func (a *app) writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := codec.NewEncoder(w, a.jsonHandle).Encode(data)
+	if err != nil {
+		log.Printf("Error while writing JSON: %s\n", err.Error())
+	}
+}
+
+var errorStatuses = map[structures.ErrorCode]int{
+	structures.ErrorWhileDecoding:              http.StatusBadRequest,
+	structures.ErrorInvalidInputs:              http.StatusBadRequest,
+	structures.ErrorValidation:                 http.StatusBadRequest,
+	structures.ErrorUnsupportedProtocolVersion: http.StatusBadRequest,
+	structures.ErrorInvalidSignature:           http.StatusUnauthorized,
+	structures.ErrorUnauthorized:               http.StatusUnauthorized,
+	structures.ErrorInsufficientScope:          http.StatusForbidden,
+	structures.ErrorQuotaExceeded:              http.StatusForbidden,
+	structures.ErrorNotFound:                   http.StatusNotFound,
+	structures.ErrorConflict:                   http.StatusConflict,
+	structures.ErrorRateLimited:                http.StatusTooManyRequests,
+	structures.ErrorFeedUnreachable:            http.StatusBadGateway,
+}
+
+// writeAPIFailure is the HTTP counterpart of connection.respond's error path, messages follow Accept-Language
+func (a *app) writeAPIFailure(w http.ResponseWriter, r *http.Request, err error) {
+	msg := errorMessage(i18n.NewLocalizer(a.i18nBundle, r.Header.Get("Accept-Language")), err)
+	status, ok := errorStatuses[msg.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	if msg.RetryAfter != 0 {
+		w.Header().Set("Retry-After", strconv.FormatUint(uint64(msg.RetryAfter), 10))
+	}
+	a.writeJSON(w, status, msg)
+}
+
+// readJSON decodes the request's body, which is capped at the websocket's message size
+func (a *app) readJSON(w http.ResponseWriter, r *http.Request, inf interface{}) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, a.config.WebSocket.MaxMessageSize)
+	err := codec.NewDecoder(r.Body, a.jsonHandle).Decode(inf)
+	if err != nil {
+		a.writeAPIFailure(w, r, decodingError(err))
+		return false
+	}
+	return true
+}
+
+type apiHandler func(w http.ResponseWriter, r *http.Request, userID primitive.ObjectID)
+
+// withBearer authenticates, checks the scope and rate limits under the name of the equivalent websocket request
+func (a *app) withBearer(name string, scope string, h apiHandler) http.HandlerFunc {
+	if a.apiRateLimitNames == nil {
+		a.apiRateLimitNames = make(map[string]struct{})
+	}
+	a.apiRateLimitNames[name] = struct{}{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, scopes, err := a.authenticateBearer(r.Context(), r)
+		if err != nil {
+			a.writeAPIFailure(w, r, err)
+			return
+		}
+		if !hasScope(scopes, scope) {
+			a.writeAPIFailure(w, r, errInsufficientScope)
+			return
+		}
+		err = a.takeRateLimit(r.Context(), name, userSubject(userID), ipSubject(remoteIP(r.RemoteAddr)))
+		if err != nil {
+			a.writeAPIFailure(w, r, err)
+			return
+		}
+		h(w, r, userID)
+	}
+}
+
+func (a *app) registerAPI(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/openapi.json", a.apiOpenAPI)
+	mux.HandleFunc("GET /api/v1/me", a.withBearer("Me", structures.ScopeAccount, a.apiMe))
+	mux.HandleFunc("GET /api/v1/feeds", a.withBearer("ListFeeds", structures.ScopeFeedsRead, a.apiListFeeds))
+	mux.HandleFunc("POST /api/v1/feeds", a.withBearer("AddFeed", structures.ScopeFeedsWrite, a.apiAddFeed))
+	mux.HandleFunc("PUT /api/v1/feeds/{id}", a.withBearer("EditFeed", structures.ScopeFeedsWrite, a.apiEditFeed))
+	mux.HandleFunc("DELETE /api/v1/feeds/{id}", a.withBearer("DeleteFeed", structures.ScopeFeedsWrite, a.apiDeleteFeed))
+	mux.HandleFunc("GET /api/v1/output/{format}", a.apiOutput)
+	mux.HandleFunc("GET /api/v1/image", a.apiImage)
+}
+
+func pathObjectID(w http.ResponseWriter, r *http.Request, a *app) (primitive.ObjectID, bool) {
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		a.writeAPIFailure(w, r, validationError("id", "Errors.InvalidID", nil))
+		return primitive.NilObjectID, false
+	}
+	return id, true
+}
+
+func (a *app) apiMe(w http.ResponseWriter, r *http.Request, userID primitive.ObjectID) {
+	var user structures.User
+	err := a.users.FindOne(r.Context(), bson.M{"_id": userID}).Decode(&user)
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+	user.EmailVerificationToken = [32]byte{}
+	a.writeJSON(w, http.StatusOK, user)
+}
+
+func (a *app) apiListFeeds(w http.ResponseWriter, r *http.Request, userID primitive.ObjectID) {
+	query := r.URL.Query()
+	sort, _ := strconv.ParseUint(query.Get("sort"), 10, 8)
+	pageSize, _ := strconv.ParseUint(query.Get("page_size"), 10, 32)
+	req := structures.ListFeedsRequest{
+		Sort:         uint8(sort),
+		Descending:   query.Get("descending") == "true",
+		Filter:       query.Get("filter"),
+		PageSize:     uint32(pageSize),
+		Continuation: query.Get("continuation"),
+	}
+	resp, err := a.listFeeds(r.Context(), userID, &req)
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+	a.writeJSON(w, http.StatusOK, resp)
+}
+
+func (a *app) apiAddFeed(w http.ResponseWriter, r *http.Request, userID primitive.ObjectID) {
+	var req structures.Feed
+	if !a.readJSON(w, r, &req) {
+		return
+	}
+	resp, err := a.addFeed(r.Context(), userID, &req)
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+	a.writeJSON(w, http.StatusCreated, resp)
+}
+
+func (a *app) apiEditFeed(w http.ResponseWriter, r *http.Request, userID primitive.ObjectID) {
+	id, ok := pathObjectID(w, r, a)
+	if !ok {
+		return
+	}
+	var req structures.Feed
+	if !a.readJSON(w, r, &req) {
+		return
+	}
+	req.ID = id
+	resp, err := a.editFeed(r.Context(), userID, &req)
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+	a.writeJSON(w, http.StatusOK, resp)
+}
+
+func (a *app) apiDeleteFeed(w http.ResponseWriter, r *http.Request, userID primitive.ObjectID) {
+	id, ok := pathObjectID(w, r, a)
+	if !ok {
+		return
+	}
+	resp, err := a.deleteFeed(r.Context(), userID, &structures.DeleteFeedRequest{ID: id})
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+	a.writeJSON(w, http.StatusOK, resp)
+}
+
+type apiOperation struct {
+	method, path, summary string
+	request, response     interface{}
+	status                int
+}
+
+var apiOperations = []apiOperation{
+	{"get", "/api/v1/me", "The authenticated user", nil, structures.User{}, http.StatusOK},
+	{"get", "/api/v1/feeds", "List feeds, takes the fields of ListFeedsRequest as query parameters", nil, structures.ListFeedsResponse{}, http.StatusOK},
+	{"post", "/api/v1/feeds", "Add a feed, frequency is in seconds", structures.Feed{}, structures.GenericIDResponse{}, http.StatusCreated},
+	{"put", "/api/v1/feeds/{id}", "Edit a feed, frequency is in seconds", structures.Feed{}, structures.UpdatedFeedResponse{}, http.StatusOK},
+	{"delete", "/api/v1/feeds/{id}", "Delete a feed", nil, structures.DeleteFeedResponse{}, http.StatusOK},
+}
+
+// buildOpenAPI derives the document from the structures types, so it can't drift from what the handlers actually (de)serialize
+func buildOpenAPI(baseURL string) ([]byte, error) {
+	const refPrefix = "#/components/schemas/"
+	schemas := map[string]interface{}{}
+	errorSchema := structures.JSONSchema(reflect.TypeOf(structures.ErrorMessage{}), schemas, refPrefix)
+	jsonContent := func(schema interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"application/json": map[string]interface{}{"schema": schema},
+		}
+	}
+
+	paths := map[string]map[string]interface{}{}
+	for _, op := range apiOperations {
+		operation := map[string]interface{}{
+			"summary":  op.summary,
+			"security": []map[string][]string{{"bearer": {}}},
+			"responses": map[string]interface{}{
+				strconv.Itoa(op.status): map[string]interface{}{
+					"description": http.StatusText(op.status),
+					"content":     jsonContent(structures.JSONSchema(reflect.TypeOf(op.response), schemas, refPrefix)),
+				},
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content":     jsonContent(errorSchema),
+				},
+			},
+		}
+		if op.request != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  jsonContent(structures.JSONSchema(reflect.TypeOf(op.request), schemas, refPrefix)),
+			}
+		}
+		if strings.Contains(op.path, "{id}") {
+			operation["parameters"] = []map[string]interface{}{{
+				"name":     "id",
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]interface{}{"type": "string", "pattern": "^[0-9a-f]{24}$"},
+			}}
+		}
+		if paths[op.path] == nil {
+			paths[op.path] = map[string]interface{}{}
+		}
+		paths[op.path][op.method] = operation
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"openapi": "3.1.0",
+		"info": map[string]interface{}{
+			"title":   "RSS2Email",
+			"version": "1",
+		},
+		"servers": []map[string]string{{"url": baseURL}},
+		"paths":   paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearer": map[string]string{"type": "http", "scheme": "bearer"},
+			},
+		},
+	}, "", "  ")
+}
+
+func (a *app) apiOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(a.openAPIDocument)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/ugorji/go/codec"
+	"golang.org/x/text/language"
+)
+
+func newTestAPIApp() *app {
+	bundle := i18n.NewBundle(language.English)
+	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
+	_, _ = bundle.LoadMessageFileFS(localeFS, "locales/en.toml")
+	config := new(Configuration)
+	config.WebSocket.MaxMessageSize = 64
+	return &app{
+		config:     config,
+		jsonHandle: new(codec.JsonHandle),
+		i18nBundle: bundle,
+	}
+}
+
+func TestAPIFailureQuotaExceeded(t *testing.T) {
+	a := newTestAPIApp()
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/feeds", nil)
+	a.writeAPIFailure(w, r, newRequestError(structures.ErrorQuotaExceeded, "Errors.FeedQuotaExceeded", map[string]uint32{
+		"Max": 10,
+	}))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	var msg structures.ErrorMessage
+	err := codec.NewDecoderBytes(w.Body.Bytes(), a.jsonHandle).Decode(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Code != structures.ErrorQuotaExceeded {
+		t.Errorf("got code %#x, want %#x", msg.Code, structures.ErrorQuotaExceeded)
+	}
+}
+
+func TestAPIFailureFeedUnreachable(t *testing.T) {
+	a := newTestAPIApp()
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/feeds", nil)
+	a.writeAPIFailure(w, r, feedUnreachableError(errPrivateAddress))
+	if w.Code != http.StatusBadGateway {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusBadGateway)
+	}
+
+	var msg structures.ErrorMessage
+	err := codec.NewDecoderBytes(w.Body.Bytes(), a.jsonHandle).Decode(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Code != structures.ErrorFeedUnreachable {
+		t.Errorf("got code %#x, want %#x", msg.Code, structures.ErrorFeedUnreachable)
+	}
+	if strings.Contains(msg.Message, errPrivateAddress.Error()) {
+		t.Errorf("the cause leaked into %q", msg.Message)
+	}
+}
+
+func TestReadJSONBodyLimit(t *testing.T) {
+	a := newTestAPIApp()
+	var feed structures.Feed
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/feeds", strings.NewReader(`{"name":"short"}`))
+	if !a.readJSON(w, r, &feed) || feed.Name != "short" {
+		t.Fatalf("a body under the limit wasn't read, got %q", feed.Name)
+	}
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest(http.MethodPost, "/api/v1/feeds", strings.NewReader(`{"name":"`+strings.Repeat("x", 128)+`"}`))
+	if a.readJSON(w, r, &feed) {
+		t.Fatal("a body over the limit was read")
+	}
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// apiKeyPrefix tells API keys apart from session tokens, and makes leaked keys easy to grep for
+const apiKeyPrefix = "r2e_"
+
+var knownScopes = []string{
+	structures.ScopeFeedsRead,
+	structures.ScopeFeedsWrite,
+	structures.ScopeAccount,
+	structures.ScopeOutput,
+}
+
+// hasScope treats nil as unrestricted and an empty scope as needing none, feeds:write implies feeds:read which implies output
+func hasScope(scopes []string, scope string) bool {
+	if scopes == nil || len(scope) == 0 {
+		return true
+	}
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+		switch scope {
+		case structures.ScopeFeedsRead:
+			if s == structures.ScopeFeedsWrite {
+				return true
+			}
+		case structures.ScopeOutput:
+			if s == structures.ScopeFeedsRead || s == structures.ScopeFeedsWrite {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *connection) hasScope(scope string) bool {
+	return hasScope(c.scopes, scope)
+}
+
+func (a *app) lookupAPIKey(ctx context.Context, token string) (*structures.APIKey, error) {
+	var key structures.APIKey
+	err := a.apiKeys.FindOne(ctx, bson.M{
+		"hash": hashToken(token),
+	}).Decode(&key)
+	if err == mongo.ErrNoDocuments {
+		return nil, errUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !key.ExpiresAt.IsZero() && key.ExpiresAt.Before(time.Now()) {
+		return nil, errUnauthorized
+	}
+
+	_, err = a.apiKeys.UpdateByID(ctx, key.ID, bson.M{
+		"$set": bson.M{
+			"last_used": time.Now(),
+		},
+	})
+	if err != nil {
+		log.Printf("Failed while updating last_used for API key %s: %s\n", key.ID.Hex(), err.Error())
+	}
+	if key.Scopes == nil {
+		key.Scopes = []string{}
+	}
+	return &key, nil
+}
+
+func (c *connection) loginWithAPIKey(mi *MessageInfo, token string) (*structures.User, bool) {
+	key, err := c.a.lookupAPIKey(c.ctx, token)
+	if err == errUnauthorized {
+		err = errInvalidAPIKey
+	}
+	if err != nil {
+		c.writeError(mi, err)
+		return nil, false
+	}
+
+	var user structures.User
+	err = c.a.users.FindOne(c.ctx, bson.M{"_id": key.UserID}).Decode(&user)
+	if err != nil {
+		c.writeError(mi, err)
+		return nil, false
+	}
+	c.userID = user.ID
+	c.addr = user.Address
+	c.scopes = key.Scopes
+	c.apiKeyID = key.ID
+	return &user, true
+}
+
+func (c *connection) handleCreateAPIKey(ctx context.Context, req *structures.CreateAPIKeyRequest) (*structures.CreateAPIKeyResponse, error) {
+	scopes := make([]string, 0, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		known := false
+		for _, k := range knownScopes {
+			if scope == k {
+				known = true
+				break
+			}
+		}
+		// A key can never grant more than the connection creating it has
+		if !known || !c.hasScope(scope) {
+			return nil, validationError(fmt.Sprintf("scopes.%d", i), "Errors.InvalidScope", map[string]string{
+				"Scope": scope,
+			})
+		}
+		scopes = append(scopes, scope)
+	}
+
+	randBuf := make([]byte, 32)
+	_, err := io.ReadFull(rand.Reader, randBuf)
+	if err != nil {
+		return nil, err
+	}
+	token := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(randBuf)
+
+	key := structures.APIKey{
+		ID:        primitive.NewObjectID(),
+		UserID:    c.userID,
+		Name:      strings.TrimSpace(req.Name),
+		Prefix:    token[:len(apiKeyPrefix)+6],
+		Hash:      hashToken(token),
+		Scopes:    scopes,
+		CreatedAt: time.Now(),
+		ExpiresAt: req.ExpiresAt,
+	}
+	_, err = c.a.apiKeys.InsertOne(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &structures.CreateAPIKeyResponse{
+		Key:   key,
+		Token: token,
+	}, nil
+}
+
+func (c *connection) handleListAPIKeys(ctx context.Context) (*structures.ListAPIKeysResponse, error) {
+	var keys []structures.APIKey
+	cursor, err := c.a.apiKeys.Find(ctx, bson.M{
+		"user_id": c.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &keys)
+	if err != nil {
+		return nil, err
+	}
+	if keys == nil {
+		keys = []structures.APIKey{}
+	}
+	return &structures.ListAPIKeysResponse{
+		Keys: keys,
+	}, nil
+}
+
+func (c *connection) handleRevokeAPIKey(ctx context.Context, req *structures.RevokeAPIKeyRequest) (*structures.RevokeAPIKeyResponse, error) {
+	result, err := c.a.apiKeys.DeleteOne(ctx, bson.M{
+		"_id":     req.ID,
+		"user_id": c.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.DeletedCount == 0 {
+		return nil, errNotFound
+	}
+	// Sessions issued while logged in with the key would outlive it otherwise
+	_, err = c.a.sessions.DeleteMany(ctx, bson.M{
+		"key_id":  req.ID,
+		"user_id": c.userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &structures.RevokeAPIKeyResponse{
+		DeletedCount: result.DeletedCount,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/ugorji/go/codec"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/xerrors"
+)
+
+// transientTransactionError is the label the server puts on errors that are worth retrying the transaction for
+const transientTransactionError = "TransientTransactionError"
+
+var (
+	errBatchTooLarge = validationError("requests", "Errors.BatchTooLarge", map[string]int{
+		"Max": structures.MaxBatchSize,
+	})
+	// errBatchItemFailed aborts an atomic batch's transaction, the failing item's own error is in its result
+	errBatchItemFailed = xerrors.New("batch item failed")
+)
+
+// handleBatch runs the sub-requests in order. Atomic batches need Mongo to be a replica set, and only roll back what's in
+// the database, events and e-mails from the items that did run are still sent.
+func (c *connection) handleBatch(ctx context.Context, req *structures.BatchRequest) (*structures.BatchResponse, error) {
+	if len(req.Requests) > structures.MaxBatchSize {
+		return nil, errBatchTooLarge
+	}
+
+	if !req.Atomic {
+		results := make([]structures.BatchResult, 0, len(req.Requests))
+		for i, item := range req.Requests {
+			result, _ := c.runBatchItem(ctx, i, item)
+			results = append(results, result)
+		}
+		return &structures.BatchResponse{
+			Committed: true,
+			Results:   results,
+		}, nil
+	}
+
+	session, err := c.a.conn.StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	var results []structures.BatchResult
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		// Transient errors make WithTransaction start over, so the results of an earlier attempt are dropped
+		results = make([]structures.BatchResult, 0, len(req.Requests))
+		for i, item := range req.Requests {
+			result, err := c.runBatchItem(sc, i, item)
+			results = append(results, result)
+			if err != nil {
+				var se mongo.ServerError
+				if errors.As(err, &se) && se.HasErrorLabel(transientTransactionError) {
+					return nil, err
+				}
+				return nil, errBatchItemFailed
+			}
+		}
+		return nil, nil
+	})
+	if errors.Is(err, errBatchItemFailed) {
+		return &structures.BatchResponse{
+			Committed: false,
+			Results:   results,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &structures.BatchResponse{
+		Committed: true,
+		Results:   results,
+	}, nil
+}
+
+// runBatchItem goes through the same checks as a standalone request, the error is returned as well for atomic batches
+func (c *connection) runBatchItem(ctx context.Context, index int, item structures.BatchItem) (structures.BatchResult, error) {
+	r, ok := requestRoutes[item.RequestID]
+	var resp interface{}
+	var err error
+	switch {
+	case !ok || r.raw != nil || item.RequestID == structures.RequestBatch:
+		err = validationError(fmt.Sprintf("requests.%d.request_id", index), "Errors.NotBatchable", nil)
+	case !c.hasScope(r.scope):
+		err = errInsufficientScope
+	default:
+		// Every item counts, batching isn't a way around the limits
+		err = c.takeRateLimit(ctx, item.RequestID)
+		if err == nil {
+			resp, err = r.call(c, ctx, item.Payload)
+		}
+	}
+
+	if err == nil {
+		var payload []byte
+		err = codec.NewEncoderBytes(&payload, c.a.codecHandle).Encode(resp)
+		if err == nil {
+			return structures.BatchResult{
+				OK:      true,
+				Payload: payload,
+			}, nil
+		}
+	}
+	return structures.BatchResult{
+		Error: errorMessage(c.localizer, err),
+	}, err
+}
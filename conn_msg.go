@@ -1,16 +1,17 @@
 package main
 
 import (
-	"context"
 	"encoding/binary"
 	"io"
 	"log"
 
-	"git.maharshi.ninja/root/rss2email/structures"
 	"github.com/ugorji/go/codec"
+	"golang.org/x/xerrors"
 	"nhooyr.io/websocket"
 )
 
+var errNotBinary = xerrors.New("expected a binary message")
+
 type MessageInfo struct {
 	ID        uint32
 	RequestID uint32
@@ -30,11 +31,13 @@ func (c *connection) readMessage(inf interface{}) (*MessageInfo, bool) {
 }
 
 func (c *connection) readMessageInfo() (*MessageInfo, []byte, bool) {
-	mtype, rdr, err := c.conn.Reader(context.TODO())
-	if mtype != websocket.MessageBinary || err != nil {
-		c.writeMessage(false, nil, structures.ErrorMessage{
-			Code: structures.ErrorWhileDecoding,
-		})
+	mtype, rdr, err := c.conn.Reader(c.ctx)
+	if err != nil {
+		c.writeError(nil, decodingError(err))
+		return nil, nil, false
+	}
+	if mtype != websocket.MessageBinary {
+		c.writeError(nil, decodingError(errNotBinary))
 		return nil, nil, false
 	}
 	mi := new(MessageInfo)
@@ -43,10 +46,7 @@ func (c *connection) readMessageInfo() (*MessageInfo, []byte, bool) {
 		buf := make([]byte, 8)
 		_, err = io.ReadFull(rdr, buf)
 		if err != nil {
-			c.writeMessage(false, nil, structures.ErrorMessage{
-				Code:    structures.ErrorWhileDecoding,
-				Message: err.Error(),
-			})
+			c.writeError(nil, decodingError(err))
 			return nil, nil, false
 		}
 		mi.ID = binary.LittleEndian.Uint32(buf[0:4])
@@ -55,10 +55,7 @@ func (c *connection) readMessageInfo() (*MessageInfo, []byte, bool) {
 
 	rest, err := io.ReadAll(rdr)
 	if err != nil {
-		c.writeMessage(false, nil, structures.ErrorMessage{
-			Code:    structures.ErrorWhileDecoding,
-			Message: err.Error(),
-		})
+		c.writeError(nil, decodingError(err))
 		return nil, nil, false
 	}
 
@@ -69,25 +66,22 @@ func (c *connection) decodeToInterface(buf []byte, inf interface{}) bool {
 	d := codec.NewDecoderBytes(buf, c.a.codecHandle)
 	err := d.Decode(inf)
 	if err != nil {
-		log.Printf("Errored while decoding: %s\n", err.Error())
-		c.writeMessage(false, nil, structures.ErrorMessage{
-			Code:    structures.ErrorWhileDecoding,
-			Message: err.Error(),
-		})
+		c.writeError(nil, decodingError(err))
 		return false
 	}
 	return true
 }
 
-func (c *connection) writeError(m *MessageInfo, code structures.ErrorCode, err error) {
-	c.writeMessage(false, m, structures.ErrorMessage{
-		Code:    code,
-		Message: err.Error(),
-	})
+func (c *connection) writeError(m *MessageInfo, err error) {
+	c.writeMessage(false, m, errorMessage(c.localizer, err))
 }
 
+// writeMessage is safe to call from multiple goroutines, the websocket only permits a single writer at a time
 func (c *connection) writeMessage(ok bool, m *MessageInfo, data interface{}) {
-	wr, err := c.conn.Writer(context.TODO(), websocket.MessageBinary)
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	wr, err := c.conn.Writer(c.ctx, websocket.MessageBinary)
 	if err != nil {
 		log.Printf("Error while writing: %s\n", err.Error())
 		c.conn.Close(websocket.StatusInternalError, "???")
@@ -17,48 +17,36 @@ import (
 	smtp "github.com/xhit/go-simple-mail/v2"
 )
 
-func (c *connection) handleEmailVerification(mi *MessageInfo, buf []byte) {
-	var req structures.VerifyEmailRequest
-	ok := c.decodeToInterface(buf, &req)
-	if !ok {
-		return
-	}
-
-	u := c.getUser(mi)
-	if u == nil {
-		return
+func (c *connection) handleEmailVerification(ctx context.Context, req *structures.VerifyEmailRequest) (*structures.GenericIDResponse, error) {
+	u, err := c.getUser(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	if u.EmailVerified {
-		c.writeMessage(false, mi, structures.ErrorMessage{
-			Code: structures.ErrorInvalidInputs,
-			Message: c.localizer.MustLocalize(&i18n.LocalizeConfig{
-				MessageID: "Errors.AlreadyVerified",
-			}),
-		})
-		return
+		return nil, newRequestError(structures.ErrorConflict, "Errors.AlreadyVerified", nil)
 	}
 
 	if subtle.ConstantTimeCompare(u.EmailVerificationToken[:], req.Token[:]) != 1 {
-		c.writeMessage(false, mi, structures.ErrorMessage{
-			Code: structures.ErrorInvalidInputs,
-			Message: c.localizer.MustLocalize(&i18n.LocalizeConfig{
-				MessageID: "Errors.InvalidVerificationToken",
-			}),
-		})
-		return
+		return nil, validationError("token", "Errors.InvalidVerificationToken", nil)
 	}
 
-	c.a.users.UpdateByID(context.TODO(), c.userID, bson.M{
+	_, err = c.a.users.UpdateByID(ctx, c.userID, bson.M{
 		"$set": bson.M{
 			"email_verification_last": time.Now(),
 			"email_verified":          true,
 		},
 	})
-	c.writeMessage(true, mi, structures.GenericIDResponse{
+	if err != nil {
+		return nil, err
+	}
+	c.a.publishEvent(c.userID, structures.Event{
+		Type: structures.EventEmailVerified,
+	})
+	return &structures.GenericIDResponse{
 		OK: true,
 		ID: c.userID,
-	})
+	}, nil
 }
 
 func setEmailToAddress(msg *smtp.Email, address string) error {
@@ -77,14 +65,28 @@ func setEmailToAddress(msg *smtp.Email, address string) error {
 	return nil
 }
 
-func (c *connection) handleEmailRequest(mi *MessageInfo, _ []byte) {
-	u := c.getUser(mi)
+// verificationEmailInterval is how long a user has to wait before asking for another verification e-mail
+const verificationEmailInterval = 6 * time.Hour
+
+func (c *connection) handleEmailRequest(ctx context.Context) (bool, error) {
+	u, err := c.getUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	if u.EmailVerified {
+		return false, newRequestError(structures.ErrorConflict, "Errors.AlreadyVerified", nil)
+	}
+	if wait := verificationEmailInterval - time.Since(u.EmailVerificationLast); wait >= 0 {
+		err := rateLimitedError(wait)
+		err.MessageID = "Errors.VerificationEmailRateLimited"
+		return false, err
+	}
 	c.sendVerificationEmail(u)
-	c.writeMessage(true, mi, true)
+	return true, nil
 }
 
 func (c *connection) sendVerificationEmail(user *structures.User) {
-	if time.Since(user.EmailVerificationLast) <= 6*time.Hour {
+	if time.Since(user.EmailVerificationLast) <= verificationEmailInterval {
 		log.Printf("Ignoring email verification request since it was sent within the past 6 hours. User ID: %#v\n", user.ID)
 		return
 	}
@@ -122,7 +124,7 @@ func (c *connection) sendVerificationEmail(user *structures.User) {
 		return
 	}
 
-	_, err = c.a.users.UpdateByID(context.TODO(), user.ID, bson.M{
+	_, err = c.a.users.UpdateByID(c.ctx, user.ID, bson.M{
 		"$set": bson.M{
 			"email_verification_last": time.Now(),
 		},
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/mmcdole/gofeed"
+	smtp "github.com/xhit/go-simple-mail/v2"
+)
+
+const (
+	defaultEnclosureTimeout      = 60
+	defaultEnclosureMaxSize      = 10 << 20
+	defaultEnclosureMaxTotalSize = 20 << 20
+)
+
+func formatSize(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatUint(n, 10) + " B"
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// itemDuration normalizes the iTunes duration, which feeds give in seconds or as [[h:]m:]s, to h:mm:ss
+func itemDuration(item *gofeed.Item) string {
+	if item.ITunesExt == nil || len(item.ITunesExt.Duration) == 0 {
+		return ""
+	}
+	var seconds uint64
+	for _, part := range strings.Split(strings.TrimSpace(item.ITunesExt.Duration), ":") {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			// Not worth guessing at, shown as the feed has it
+			return item.ITunesExt.Duration
+		}
+		seconds = seconds*60 + n
+	}
+	return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// writeEnclosures lists the enclosures with what's known about them, attached says which ones are also attached
+func writeEnclosures(bldr *strings.Builder, item *gofeed.Item, attached []bool) {
+	if duration := itemDuration(item); len(duration) != 0 {
+		bldr.WriteString("Duration: ")
+		bldr.WriteString(duration)
+		bldr.WriteRune('\n')
+	}
+	if ext := item.ITunesExt; ext != nil && len(ext.Episode) != 0 {
+		bldr.WriteString("Episode: ")
+		if len(ext.Season) != 0 {
+			bldr.WriteString(ext.Season)
+			bldr.WriteRune('.')
+		}
+		bldr.WriteString(ext.Episode)
+		bldr.WriteRune('\n')
+	}
+	enclosures := itemEnclosures(item)
+	if len(enclosures) == 0 {
+		return
+	}
+	bldr.WriteString("Enclosures:\n")
+	for num, e := range enclosures {
+		var details []string
+		if len(e.Type) != 0 {
+			details = append(details, e.Type)
+		}
+		if e.Length != 0 {
+			details = append(details, formatSize(e.Length))
+		}
+		if num < len(attached) && attached[num] {
+			details = append(details, "attached")
+		}
+		bldr.WriteString(strconv.Itoa(num + 1))
+		bldr.WriteString(". ")
+		bldr.WriteString(e.URL)
+		if len(details) != 0 {
+			bldr.WriteString(" (")
+			bldr.WriteString(strings.Join(details, ", "))
+			bldr.WriteRune(')')
+		}
+		bldr.WriteRune('\n')
+	}
+}
+
+// enclosureAttachments downloads the enclosures of feeds that attach them, in order, as long as they fit the limits. What
+// doesn't fit or fails to download is only linked.
+func (a *app) enclosureAttachments(feed *structures.Feed, item *gofeed.Item) ([]*smtp.File, []bool) {
+	enclosures := itemEnclosures(item)
+	attached := make([]bool, len(enclosures))
+	if !feed.AttachEnclosures {
+		return nil, attached
+	}
+
+	var files []*smtp.File
+	remaining := a.config.Enclosures.MaxTotalSize
+	for i, e := range enclosures {
+		limit := min(a.config.Enclosures.MaxSize, remaining)
+		// The declared length may be wrong, it's only trusted to skip what's surely too large
+		if limit <= 0 || e.Length > uint64(limit) {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(a.config.Enclosures.Timeout)*time.Second)
+		file, err := a.fetcher.get(ctx, e.URL, "", limit)
+		cancel()
+		if err != nil {
+			log.Printf("Failed while downloading the enclosure %s (%s), failed with %s\n", e.URL, hex.EncodeToString(feed.ID[:]), err.Error())
+			continue
+		}
+
+		name := path.Base(file.URL.Path)
+		if name == "/" || name == "." {
+			name = "enclosure-" + strconv.Itoa(i+1)
+		}
+		mediaType := file.MediaType
+		if len(mediaType) == 0 {
+			mediaType = e.Type
+		}
+		files = append(files, &smtp.File{
+			Name:     name,
+			MimeType: mediaType,
+			Data:     file.Body,
+		})
+		attached[i] = true
+		remaining -= int64(len(file.Body))
+	}
+	return files, attached
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// requestError is an error meant for the client, its message is localized when it's written out. Any other error coming out of
+// a handler is reported as ErrorInternal with a correlation ID, the details only go to the log.
+type requestError struct {
+	Code         structures.ErrorCode
+	MessageID    string
+	TemplateData interface{}
+	// Field points at the offending input for validation errors, e.g. "scopes.2"
+	Field string
+	// Cause is logged under a correlation ID, it's never shown to the client
+	Cause error
+	// In seconds, for ErrorRateLimited
+	RetryAfter uint32
+}
+
+func (e *requestError) Error() string {
+	if e.Cause != nil {
+		return e.MessageID + ": " + e.Cause.Error()
+	}
+	return e.MessageID
+}
+
+func (e *requestError) Unwrap() error {
+	return e.Cause
+}
+
+func newRequestError(code structures.ErrorCode, messageID string, templateData interface{}) *requestError {
+	return &requestError{
+		Code:         code,
+		MessageID:    messageID,
+		TemplateData: templateData,
+	}
+}
+
+func validationError(field string, messageID string, templateData interface{}) *requestError {
+	return &requestError{
+		Code:         structures.ErrorValidation,
+		MessageID:    messageID,
+		TemplateData: templateData,
+		Field:        field,
+	}
+}
+
+func decodingError(cause error) *requestError {
+	return &requestError{
+		Code:      structures.ErrorWhileDecoding,
+		MessageID: "Errors.Decoding",
+		Cause:     cause,
+	}
+}
+
+// invalidSignatureError keeps the verifier's error, if any, out of the response
+func invalidSignatureError(cause error) *requestError {
+	return &requestError{
+		Code:      structures.ErrorInvalidSignature,
+		MessageID: "Errors.InvalidSignature",
+		Cause:     cause,
+	}
+}
+
+// feedUnreachableError tells the client why a feed couldn't be fetched, as far as fetchFailure deems safe
+func feedUnreachableError(cause error) *requestError {
+	return &requestError{
+		Code:      structures.ErrorFeedUnreachable,
+		MessageID: "Errors.FeedUnreachable",
+		TemplateData: map[string]string{
+			"Reason": fetchFailure(cause),
+		},
+		Cause: cause,
+	}
+}
+
+var (
+	errNotFound       = newRequestError(structures.ErrorNotFound, "Errors.NotFound", nil)
+	errConflict       = newRequestError(structures.ErrorConflict, "Errors.Conflict", nil)
+	errUnauthorized   = newRequestError(structures.ErrorUnauthorized, "Errors.Unauthorized", nil)
+	errUnknownRequest = newRequestError(structures.ErrorInvalidInputs, "Errors.UnknownRequest", nil)
+	// Only API keys are ever restricted
+	errInsufficientScope = newRequestError(structures.ErrorInsufficientScope, "Errors.InsufficientScope", nil)
+	errInvalidAPIKey     = newRequestError(structures.ErrorUnauthorized, "Errors.InvalidAPIKey", nil)
+)
+
+// asRequestError maps err to what the client gets to see, internal errors become nil
+func asRequestError(err error) *requestError {
+	var re *requestError
+	switch {
+	case errors.As(err, &re):
+		return re
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return errNotFound
+	case mongo.IsDuplicateKeyError(err):
+		return errConflict
+	}
+	return nil
+}
+
+func errorCode(err error) structures.ErrorCode {
+	re := asRequestError(err)
+	if re == nil {
+		return structures.ErrorInternal
+	}
+	return re.Code
+}
+
+func newCorrelationID() string {
+	buf := make([]byte, 8)
+	_, err := io.ReadFull(rand.Reader, buf)
+	if err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf)
+}
+
+// errorMessage is the only way errors are turned into ErrorMessages, for both the websocket and the HTTP API
+func errorMessage(localizer *i18n.Localizer, err error) structures.ErrorMessage {
+	re := asRequestError(err)
+	if re == nil {
+		re = &requestError{
+			Code:      structures.ErrorInternal,
+			MessageID: "Errors.Internal",
+			Cause:     err,
+		}
+	}
+
+	msg := structures.ErrorMessage{
+		Code:       re.Code,
+		Field:      re.Field,
+		RetryAfter: re.RetryAfter,
+	}
+	templateData := re.TemplateData
+	if re.Cause != nil {
+		msg.CorrelationID = newCorrelationID()
+		log.Printf("Error [%s]: %s\n", msg.CorrelationID, err.Error())
+		if re.Code == structures.ErrorInternal {
+			templateData = map[string]string{
+				"CorrelationID": msg.CorrelationID,
+			}
+		}
+	}
+	msg.Message = localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID:    re.MessageID,
+		TemplateData: templateData,
+	})
+	return msg
+}
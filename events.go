@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EventBroker delivers server-side events to the connections of a user, wherever they are
+type EventBroker interface {
+	Publish(ctx context.Context, userID primitive.ObjectID, ev structures.Event) error
+	Subscribe(userID primitive.ObjectID) (<-chan structures.Event, func())
+}
+
+// eventBufferSize is how many events a slow connection may lag behind before events get dropped for it
+const eventBufferSize = 32
+
+// localBroker only reaches connections on this very process
+type localBroker struct {
+	mu   sync.RWMutex
+	subs map[primitive.ObjectID]map[chan structures.Event]struct{}
+}
+
+func newLocalBroker() *localBroker {
+	return &localBroker{
+		subs: make(map[primitive.ObjectID]map[chan structures.Event]struct{}),
+	}
+}
+
+func (b *localBroker) Publish(_ context.Context, userID primitive.ObjectID, ev structures.Event) error {
+	b.deliver(userID, ev)
+	return nil
+}
+
+func (b *localBroker) deliver(userID primitive.ObjectID, ev structures.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subs[userID] {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (b *localBroker) Subscribe(userID primitive.ObjectID) (<-chan structures.Event, func()) {
+	ch := make(chan structures.Event, eventBufferSize)
+	b.mu.Lock()
+	if b.subs[userID] == nil {
+		b.subs[userID] = make(map[chan structures.Event]struct{})
+	}
+	b.subs[userID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs[userID], ch)
+		if len(b.subs[userID]) == 0 {
+			delete(b.subs, userID)
+		}
+		b.mu.Unlock()
+	}
+}
+
+type storedEvent struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Event     structures.Event   `bson:"event"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// mongoBroker fans events out across instances by inserting them into a collection every instance watches through a change stream, requires a replica set
+type mongoBroker struct {
+	*localBroker
+	events *mongo.Collection
+}
+
+func newMongoBroker(events *mongo.Collection) *mongoBroker {
+	return &mongoBroker{
+		localBroker: newLocalBroker(),
+		events:      events,
+	}
+}
+
+func (b *mongoBroker) Publish(ctx context.Context, userID primitive.ObjectID, ev structures.Event) error {
+	_, err := b.events.InsertOne(ctx, storedEvent{
+		ID:        primitive.NewObjectID(),
+		UserID:    userID,
+		Event:     ev,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+func (b *mongoBroker) watch(ctx context.Context) {
+	for {
+		stream, err := b.events.Watch(ctx, mongo.Pipeline{
+			bson.D{{Key: "$match", Value: bson.M{"operationType": "insert"}}},
+		}, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+		if err != nil {
+			log.Printf("Couldn't open the event change stream, retrying: %s\n", err.Error())
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		for stream.Next(ctx) {
+			var change struct {
+				FullDocument storedEvent `bson:"fullDocument"`
+			}
+			err = stream.Decode(&change)
+			if err != nil {
+				log.Printf("Couldn't decode a change stream event: %s\n", err.Error())
+				continue
+			}
+			b.deliver(change.FullDocument.UserID, change.FullDocument.Event)
+		}
+		if err = stream.Err(); err != nil {
+			log.Printf("Event change stream broke, reopening: %s\n", err.Error())
+		}
+		_ = stream.Close(context.TODO())
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (a *app) publishEvent(userID primitive.ObjectID, ev structures.Event) {
+	ev.Timestamp = time.Now()
+	err := a.events.Publish(context.TODO(), userID, ev)
+	if err != nil {
+		log.Printf("Failed while publishing event %d for %s: %s\n", ev.Type, userID.Hex(), err.Error())
+	}
+}
+
+// forwardEvents pushes every event for the logged in user down the websocket until the connection goes away
+func (c *connection) forwardEvents() {
+	events, unsubscribe := c.a.events.Subscribe(c.userID)
+	defer unsubscribe()
+	eventInfo := &MessageInfo{ID: structures.EventMessageID}
+	for {
+		select {
+		case ev := <-events:
+			c.writeMessage(true, eventInfo, ev)
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
@@ -2,123 +2,390 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"net/url"
+	"regexp"
 	"time"
 
 	"git.maharshi.ninja/root/rss2email/structures"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// MinimumFrequency == 10min
+// feedCheckTimeout is how long adding a feed waits on it to be fetched once
+const feedCheckTimeout = 20 * time.Second
+
+// MinimumFrequency == 10min, the default for Quota.MinimumFrequency
 const MinimumFrequency = 10 * time.Minute
 
-func (c *connection) handleAddFeed(mi *MessageInfo, buf []byte) {
-	var req structures.Feed
-	ok := c.decodeToInterface(buf, &req)
-	if !ok {
-		return
+// The feed operations are shared between the websocket and the HTTP API, the handlers below only deal with the framing
+
+var (
+	errInvalidFeedURL  = validationError("feed_url", "Errors.InvalidFeedURL", nil)
+	errInvalidDelivery = validationError("delivery", "Errors.InvalidDelivery", nil)
+	errInvalidImages   = validationError("images", "Errors.InvalidImages", nil)
+)
+
+func validFeedURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && len(u.Host) != 0
+}
+
+// validateFeed checks what clients set on feeds and saved searches alike
+func (a *app) validateFeed(ctx context.Context, userID primitive.ObjectID, req *structures.Feed) error {
+	if req.Identity > structures.IdentityHash {
+		return errInvalidIdentity
+	}
+	if req.Delivery > structures.DeliveryNone {
+		return errInvalidDelivery
+	}
+	if req.Images > structures.ImagesProxy {
+		return errInvalidImages
+	}
+	if len(req.Query) != 0 {
+		return a.validateSavedSearch(ctx, userID, req)
+	}
+	if !validFeedURL(req.URL) {
+		return errInvalidFeedURL
+	}
+	return nil
+}
+
+func (a *app) addFeed(ctx context.Context, userID primitive.ObjectID, req *structures.Feed) (*structures.GenericIDResponse, error) {
+	err := a.validateFeed(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	quota, err := a.quotaFor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Concurrent adds may overshoot by a few, that's not worth a transaction
+	count, err := a.feeds.CountDocuments(ctx, bson.M{
+		"owner_id": userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if uint64(count) >= uint64(quota.MaxFeeds) {
+		return nil, newRequestError(structures.ErrorQuotaExceeded, "Errors.FeedQuotaExceeded", map[string]uint32{
+			"Max": quota.MaxFeeds,
+		})
+	}
+
+	// A URL that doesn't give a feed is most likely a typo, better to tell now than through a failing feed
+	if len(req.Query) == 0 {
+		fetchCtx, cancel := context.WithTimeout(ctx, feedCheckTimeout)
+		_, err = a.feedParser.ParseURLWithContext(req.URL, fetchCtx)
+		cancel()
+		if err != nil {
+			return nil, feedUnreachableError(err)
+		}
 	}
 
 	req.CreatedAt = time.Now()
 	req.UpdatedAt = time.Now()
 	req.ID = primitive.NewObjectID()
-	req.Owner = c.userID
+	req.Owner = userID
 	req.Frequency = req.Frequency * time.Second
 	req.LastFetched = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	if req.Frequency < MinimumFrequency {
-		req.Frequency = MinimumFrequency
+	req.LastError = ""
+	req.FailingSince = time.Time{}
+	req.FirstFetched = time.Time{}
+	if req.Frequency < quota.MinimumFrequency {
+		req.Frequency = quota.MinimumFrequency
 	}
 
-	f, err := c.a.feeds.InsertOne(context.TODO(), req)
+	f, err := a.feeds.InsertOne(ctx, req)
 	if err != nil {
-		c.writeError(mi, structures.ErrorInternal, err)
-		return
+		return nil, err
 	}
-	c.writeMessage(true, mi, structures.GenericIDResponse{
+	return &structures.GenericIDResponse{
 		OK: true,
 		ID: f.InsertedID.(primitive.ObjectID),
-	})
+	}, nil
 }
 
-func (c *connection) handleEditFeed(mi *MessageInfo, buf []byte) {
-	var req structures.Feed
-	ok := c.decodeToInterface(buf, &req)
-	if !ok {
-		return
+func (a *app) editFeed(ctx context.Context, userID primitive.ObjectID, req *structures.Feed) (*structures.UpdatedFeedResponse, error) {
+	err := a.validateFeed(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	quota, err := a.quotaFor(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
-	if req.Frequency < MinimumFrequency {
-		req.Frequency = MinimumFrequency
+	if req.Frequency < quota.MinimumFrequency {
+		req.Frequency = quota.MinimumFrequency
 	}
-	f, err := c.a.feeds.UpdateOne(context.TODO(), bson.M{
+	filter := bson.M{
 		"_id":      req.ID,
-		"owner_id": c.userID,
-	}, bson.M{
+		"owner_id": userID,
+	}
+	// A feed can't be turned into a saved search or the other way around
+	if len(req.Query) != 0 {
+		filter["query"] = bson.M{"$gt": ""}
+	} else {
+		filter["query"] = bson.M{"$in": bson.A{nil, ""}}
+	}
+	// Every item gets a new key with a new identity, they'd all look new without recording them first
+	identityChanged := bson.M{"$ne": req.Identity}
+	if req.Identity == structures.IdentityGUID {
+		// Feeds from before identities existed have none, which is the same as GUID
+		identityChanged["$exists"] = true
+	}
+	rekeyed := bson.M{"identity": identityChanged}
+	for k, v := range filter {
+		rekeyed[k] = v
+	}
+	_, err = a.feeds.UpdateOne(ctx, rekeyed, bson.M{
 		"$set": bson.M{
-			"name":      req.Name,
-			"feed_url":  req.URL,
-			"frequency": req.Frequency,
+			"rebaseline": true,
 		},
 	})
 	if err != nil {
-		c.writeError(mi, structures.ErrorInternal, err)
-		return
+		return nil, err
 	}
-	c.writeMessage(true, mi, structures.UpdatedFeedResponse{
-		ModifiedCount: uint64(f.ModifiedCount),
+	f, err := a.feeds.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{
+			"name":              req.Name,
+			"feed_url":          req.URL,
+			"frequency":         req.Frequency,
+			"identity":          req.Identity,
+			"notify_updates":    req.NotifyUpdates,
+			"full_text":         req.FullText,
+			"attach_enclosures": req.AttachEnclosures,
+			"images":            req.Images,
+			"delivery":          req.Delivery,
+			"query":             req.Query,
+			"source_feeds":      req.SourceFeeds,
+		},
 	})
+	if err != nil {
+		return nil, err
+	}
+	if f.MatchedCount == 0 {
+		return nil, errNotFound
+	}
+	return &structures.UpdatedFeedResponse{
+		ModifiedCount: uint64(f.ModifiedCount),
+	}, nil
 }
 
-func (c *connection) handleDeleteFeed(mi *MessageInfo, buf []byte) {
-	var req structures.DeleteFeedRequest
-	ok := c.decodeToInterface(buf, &req)
-	if !ok {
-		return
-	}
-	result, err := c.a.feeds.DeleteOne(context.TODO(), bson.M{
+func (a *app) deleteFeed(ctx context.Context, userID primitive.ObjectID, req *structures.DeleteFeedRequest) (*structures.DeleteFeedResponse, error) {
+	result, err := a.feeds.DeleteOne(ctx, bson.M{
 		"_id":      req.ID,
-		"owner_id": c.userID,
+		"owner_id": userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.DeletedCount == 0 {
+		return nil, errNotFound
+	}
+	_, err = a.items.DeleteMany(ctx, bson.M{
+		"feed_id": req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = a.seenItems.DeleteMany(ctx, bson.M{
+		"feed_id": req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// In case it was a saved search
+	_, err = a.items.UpdateMany(ctx, bson.M{
+		"owner_id": userID,
+		"searches": req.ID,
+	}, bson.M{
+		"$pull": bson.M{"searches": req.ID},
 	})
 	if err != nil {
-		c.writeError(mi, structures.ErrorInternal, err)
-		return
+		return nil, err
 	}
-	c.writeMessage(true, mi, structures.DeleteFeedResponse{
+	return &structures.DeleteFeedResponse{
 		DeletedCount: result.DeletedCount,
+	}, nil
+}
+
+const (
+	defaultFeedPageSize = 100
+	maxFeedPageSize     = 1000
+)
+
+var (
+	errInvalidSort         = validationError("sort", "Errors.InvalidSort", nil)
+	errInvalidContinuation = validationError("continuation", "Errors.InvalidContinuation", nil)
+)
+
+var feedSortFields = map[uint8]string{
+	structures.SortByCreated:     "created_at",
+	structures.SortByName:        "name",
+	structures.SortByLastFetched: "last_fetched",
+	structures.SortByErrorState:  "failing_since",
+}
+
+// feedContinuation is what the opaque continuation token decodes to, the listing options are included so a token can't be replayed against another query
+type feedContinuation struct {
+	Sort       uint8              `bson:"s"`
+	Descending bool               `bson:"d"`
+	Filter     string             `bson:"f"`
+	LastValue  bson.RawValue      `bson:"v"`
+	LastID     primitive.ObjectID `bson:"id"`
+}
+
+func feedSortValue(sort uint8, f *structures.Feed) interface{} {
+	switch sort {
+	case structures.SortByName:
+		return f.Name
+	case structures.SortByLastFetched:
+		return f.LastFetched
+	case structures.SortByErrorState:
+		return f.FailingSince
+	default:
+		return f.CreatedAt
+	}
+}
+
+// backfillFeeds gives feeds from before failing_since existed its zero value. A missing field never matches the range
+// queries continuations are built on, so those feeds would drop out of every page after the first when sorting by error
+// state.
+func (a *app) backfillFeeds(ctx context.Context) error {
+	_, err := a.feeds.UpdateMany(ctx, bson.M{
+		"failing_since": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"failing_since": time.Time{},
+		},
 	})
+	return err
 }
 
-func (c *connection) handleListFeeds(mi *MessageInfo, buf []byte) {
-	var req structures.ListFeedsRequest
-	ok := c.decodeToInterface(buf, &req)
+func (a *app) listFeeds(ctx context.Context, userID primitive.ObjectID, req *structures.ListFeedsRequest) (*structures.ListFeedsResponse, error) {
+	sortField, ok := feedSortFields[req.Sort]
 	if !ok {
-		return
+		return nil, errInvalidSort
+	}
+	descending := req.Descending
+	// Error state only makes sense with the failing feeds on top
+	if req.Sort == structures.SortByErrorState {
+		descending = !descending
+	}
+	direction, comparison := 1, "$gt"
+	if descending {
+		direction, comparison = -1, "$lt"
+	}
+
+	pageSize := int64(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultFeedPageSize
+	}
+	if pageSize > maxFeedPageSize {
+		pageSize = maxFeedPageSize
+	}
+
+	filter := bson.M{
+		"owner_id": userID,
+	}
+	if len(req.Filter) != 0 {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(req.Filter), Options: "i"}
+		filter["$or"] = bson.A{
+			bson.M{"name": pattern},
+			bson.M{"feed_url": pattern},
+			bson.M{"query": pattern},
+		}
+	}
+
+	total, err := a.feeds.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pageFilter := filter
+	if len(req.Continuation) != 0 {
+		raw, err := base64.RawURLEncoding.DecodeString(req.Continuation)
+		if err != nil {
+			return nil, errInvalidContinuation
+		}
+		var cont feedContinuation
+		err = bson.Unmarshal(raw, &cont)
+		if err != nil || cont.Sort != req.Sort || cont.Descending != req.Descending || cont.Filter != req.Filter {
+			return nil, errInvalidContinuation
+		}
+		pageFilter = bson.M{
+			"$and": bson.A{
+				filter,
+				bson.M{"$or": bson.A{
+					bson.M{sortField: bson.M{comparison: cont.LastValue}},
+					bson.M{sortField: cont.LastValue, "_id": bson.M{comparison: cont.LastID}},
+				}},
+			},
+		}
 	}
 
 	var feeds []structures.Feed
-	cursor, err := c.a.feeds.Find(context.TODO(), bson.M{
-		"owner_id": c.userID,
-	})
+	// One extra to know whether there's another page
+	cursor, err := a.feeds.Find(ctx, pageFilter, options.Find().
+		SetSort(bson.D{{Key: sortField, Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(pageSize+1))
 	if err != nil {
-		c.writeError(mi, structures.ErrorInternal, err)
-		return
+		return nil, err
 	}
-	err = cursor.All(context.TODO(), &feeds)
+	err = cursor.All(ctx, &feeds)
 	if err != nil {
-		c.writeError(mi, structures.ErrorInternal, err)
-		return
+		return nil, err
 	}
-	err = cursor.Close(context.TODO())
+	err = cursor.Close(ctx)
 	if err != nil {
-		c.writeError(mi, structures.ErrorInternal, err)
-		return
+		return nil, err
 	}
 
 	if feeds == nil {
 		feeds = []structures.Feed{}
 	}
 
-	c.writeMessage(true, mi, structures.ListFeedsResponse{
-		Count: uint64(len(feeds)),
+	resp := &structures.ListFeedsResponse{
+		Count: uint64(total),
 		Feeds: feeds,
-	})
+	}
+	if int64(len(feeds)) > pageSize {
+		feeds = feeds[:pageSize]
+		last := &feeds[len(feeds)-1]
+		typ, value, err := bson.MarshalValue(feedSortValue(req.Sort, last))
+		if err != nil {
+			return nil, err
+		}
+		raw, err := bson.Marshal(feedContinuation{
+			Sort:       req.Sort,
+			Descending: req.Descending,
+			Filter:     req.Filter,
+			LastValue:  bson.RawValue{Type: typ, Value: value},
+			LastID:     last.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Feeds = feeds
+		resp.Continuation = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return resp, nil
+}
+
+func (c *connection) handleAddFeed(ctx context.Context, req *structures.Feed) (*structures.GenericIDResponse, error) {
+	return c.a.addFeed(ctx, c.userID, req)
+}
+
+func (c *connection) handleEditFeed(ctx context.Context, req *structures.Feed) (*structures.UpdatedFeedResponse, error) {
+	return c.a.editFeed(ctx, c.userID, req)
+}
+
+func (c *connection) handleDeleteFeed(ctx context.Context, req *structures.DeleteFeedRequest) (*structures.DeleteFeedResponse, error) {
+	return c.a.deleteFeed(ctx, c.userID, req)
+}
+
+func (c *connection) handleListFeeds(ctx context.Context, req *structures.ListFeedsRequest) (*structures.ListFeedsResponse, error) {
+	return c.a.listFeeds(ctx, c.userID, req)
 }
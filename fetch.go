@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+var (
+	errTooLarge       = xerrors.New("larger than the configured maximum")
+	errPrivateAddress = xerrors.New("refusing to connect to a non-public address")
+)
+
+// fetcher is what downloads anything besides the feeds themselves (articles, enclosures, images), its client fetches the
+// feeds too. At most perHost downloads run against a host at a time so that a feed with many new items doesn't hammer its
+// site.
+type fetcher struct {
+	client  *http.Client
+	perHost int
+
+	mu    sync.Mutex
+	hosts map[string]*hostSlots
+}
+
+type hostSlots struct {
+	sem   chan struct{}
+	users int
+}
+
+// fetched is a downloaded body, URL is where it ended up after redirects
+type fetched struct {
+	Body      []byte
+	MediaType string
+	URL       *url.URL
+}
+
+// specialPurpose are ranges netip doesn't flag that are either shared, reserved or translate to addresses that would be
+// refused themselves
+var specialPurpose = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("192.88.99.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/23"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+	netip.MustParsePrefix("fec0::/10"),
+}
+
+// publicOnly is the dialer's Control hook, it sees addresses after DNS resolution, so names pointing inward and redirects
+// are covered too. Feeds and whatever they link to are fetched on their owners' behalf and may end up in their e-mails, the
+// server's own network and the cloud's metadata endpoints are out of bounds.
+func publicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+		return errPrivateAddress
+	}
+	for _, prefix := range specialPurpose {
+		if prefix.Contains(ip) {
+			return errPrivateAddress
+		}
+	}
+	return nil
+}
+
+func newFetcher(perHost int) *fetcher {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   publicOnly,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the actual destination, defeating the check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &fetcher{
+		client:  &http.Client{Transport: transport},
+		perHost: perHost,
+		hosts:   make(map[string]*hostSlots),
+	}
+}
+
+// acquire waits for a free slot on host, the returned function gives it back
+func (f *fetcher) acquire(ctx context.Context, host string) (func(), error) {
+	f.mu.Lock()
+	slots, ok := f.hosts[host]
+	if !ok {
+		slots = &hostSlots{sem: make(chan struct{}, f.perHost)}
+		f.hosts[host] = slots
+	}
+	slots.users++
+	f.mu.Unlock()
+
+	leave := func() {
+		f.mu.Lock()
+		slots.users--
+		if slots.users == 0 {
+			delete(f.hosts, host)
+		}
+		f.mu.Unlock()
+	}
+
+	select {
+	case slots.sem <- struct{}{}:
+		return func() {
+			<-slots.sem
+			leave()
+		}, nil
+	case <-ctx.Done():
+		leave()
+		return nil, ctx.Err()
+	}
+}
+
+// get downloads link, giving up on bodies over maxSize bytes. Timeouts are up to ctx.
+func (f *fetcher) get(ctx context.Context, link string, accept string, maxSize int64) (*fetched, error) {
+	if !validFeedURL(link) {
+		return nil, errInvalidFeedURL
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := f.acquire(ctx, u.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) != 0 {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerrors.Errorf("fetching %s: %s", link, resp.Status)
+	}
+	// Known to be too large without reading any of it
+	if resp.ContentLength > maxSize {
+		return nil, errTooLarge
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxSize {
+		return nil, errTooLarge
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return &fetched{
+		Body:      body,
+		MediaType: mediaType,
+		URL:       resp.Request.URL,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+)
+
+func TestPublicOnly(t *testing.T) {
+	cases := []struct {
+		host string
+		ok   bool
+	}{
+		{"93.184.215.14", true},
+		{"2606:2800:21f:cb07:6820:80da:af6b:8b2c", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"198.18.0.1", false},
+		{"0.0.0.0", false},
+		{"255.255.255.255", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"64:ff9b::a00:1", false},
+		{"2002:a00:1::", false},
+	}
+	for _, c := range cases {
+		err := publicOnly("tcp", net.JoinHostPort(c.host, "443"), nil)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: got %v", c.host, err)
+		}
+	}
+}
+
+func TestFeedFetchRefusesLoopback(t *testing.T) {
+	srv := httptest.NewServer(nil)
+	defer srv.Close()
+	parser := gofeed.NewParser()
+	parser.Client = newFetcher(1).client
+	_, err := parser.ParseURL(srv.URL)
+	if !errors.Is(err, errPrivateAddress) {
+		t.Errorf("got %v, want %v", err, errPrivateAddress)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/xerrors"
+)
+
+const (
+	defaultFullTextTimeout = 15
+	defaultFullTextMaxSize = 2 << 20
+	defaultFullTextPerHost = 2
+	// Candidates with less text than this are most likely a teaser or a caption, not the article
+	minimumArticleText = 250
+)
+
+var (
+	errNotHTML   = xerrors.New("article isn't HTML")
+	errNoArticle = xerrors.New("couldn't find the article's content")
+)
+
+// Elements that are never part of an article's text
+const articleNoise = "script, style, noscript, iframe, form, nav, header, footer, aside, button, input, select, textarea, svg"
+
+// articleExtractor downloads the pages items link to and picks their main content
+type articleExtractor struct {
+	fetcher *fetcher
+	timeout time.Duration
+	maxSize int64
+}
+
+// Extract returns the HTML of the article at link, with its links made absolute
+func (e *articleExtractor) Extract(ctx context.Context, link string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	page, err := e.fetcher.get(ctx, link, "text/html,application/xhtml+xml", e.maxSize)
+	if err != nil {
+		return "", err
+	}
+	if page.MediaType != "text/html" && page.MediaType != "application/xhtml+xml" {
+		return "", errNotHTML
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(page.Body))
+	if err != nil {
+		return "", err
+	}
+	// Redirects may have moved the page, relative links are relative to where it ended up
+	return extractArticle(doc, page.URL)
+}
+
+// extractArticle is a cut-down readability: the noise is dropped, then an <article> is taken if there's one with enough
+// text, otherwise the element whose paragraphs carry the most text
+func extractArticle(doc *goquery.Document, base *url.URL) (string, error) {
+	doc.Find(articleNoise).Remove()
+
+	var best *goquery.Selection
+	bestScore := 0
+	doc.Find("article, [role=main], main").Each(func(_ int, s *goquery.Selection) {
+		if score := textLength(s); score > bestScore {
+			best, bestScore = s, score
+		}
+	})
+
+	if bestScore < minimumArticleText {
+		best, bestScore = nil, 0
+		scores := make(map[*html.Node]int)
+		var parents []*goquery.Selection
+		doc.Find("p, pre, blockquote").Each(func(_ int, p *goquery.Selection) {
+			n := textLength(p)
+			if n < 25 {
+				return
+			}
+			parent := p.Parent()
+			node := parent.Get(0)
+			if _, ok := scores[node]; !ok {
+				parents = append(parents, parent)
+			}
+			// Commas are a cheap sign of prose rather than link lists
+			scores[node] += 1 + n/100 + strings.Count(p.Text(), ",")
+		})
+		for _, parent := range parents {
+			if score := scores[parent.Get(0)]; score > bestScore {
+				best, bestScore = parent, score
+			}
+		}
+	}
+	if best == nil || textLength(best) < minimumArticleText {
+		return "", errNoArticle
+	}
+
+	best.Find("a[href]").Each(func(_ int, s *goquery.Selection) {
+		absolutize(s, "href", base)
+	})
+	best.Find("img[src]").Each(func(_ int, s *goquery.Selection) {
+		absolutize(s, "src", base)
+	})
+	return goquery.OuterHtml(best)
+}
+
+func textLength(s *goquery.Selection) int {
+	return len(strings.Join(strings.Fields(s.Text()), " "))
+}
+
+func absolutize(s *goquery.Selection, attr string, base *url.URL) {
+	ref, err := url.Parse(s.AttrOr(attr, ""))
+	if err != nil {
+		s.RemoveAttr(attr)
+		return
+	}
+	s.SetAttr(attr, base.ResolveReference(ref).String())
+}
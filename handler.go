@@ -7,11 +7,11 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"sync"
 	"time"
 
 	"git.maharshi.ninja/root/rss2email/structures"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
-	"github.com/storyicon/sigverify"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -20,157 +20,130 @@ import (
 
 func (a *app) handler(w http.ResponseWriter, r *http.Request) {
 	c, err := websocket.Accept(w, r, &websocket.AcceptOptions{
-		InsecureSkipVerify: true,
+		OriginPatterns: a.config.WebSocket.AllowedOrigins,
 	})
 	if err != nil {
 		log.Printf("Error: %s\n", err)
 		return
 	}
 	defer c.Close(websocket.StatusNormalClosure, "")
-	conn := connection{
+	c.SetReadLimit(a.config.WebSocket.MaxMessageSize)
+	// Cancelled as soon as the client goes away, so that in-flight requests don't keep hammering Mongo
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	conn := &connection{
 		a:    a,
 		conn: c,
+		// Replaced once the client tells its locale, errors before that are in the default language
+		localizer: i18n.NewLocalizer(a.i18nBundle),
+		ip:        remoteIP(r.RemoteAddr),
+		ctx:       ctx,
+		inFlight:  make(chan struct{}, maxInFlightRequests),
 	}
 	conn.loop()
 }
 
+const (
+	defaultMaxMessageSize   = 1 << 20
+	defaultHandshakeTimeout = 30
+)
+
+// maxInFlightRequests caps the amount of concurrently handled requests per connection, further requests aren't read until one finishes
+const maxInFlightRequests = 16
+
 type connection struct {
 	a         *app
 	conn      *websocket.Conn
 	addr      [20]byte
 	userID    primitive.ObjectID
 	localizer *i18n.Localizer
+	ip        string
+
+	// Negotiated during initialization, handlers may branch on these
+	protocolVersion uint32
+	capabilities    map[string]struct{}
+
+	// nil unless logged in with an API key
+	scopes   []string
+	apiKeyID primitive.ObjectID
+
+	ctx      context.Context
+	writeMu  sync.Mutex
+	inFlight chan struct{}
+
+	challengeMu       sync.Mutex
+	deletionChallenge []byte
 }
 
-func (c *connection) getUser(mi *MessageInfo) *structures.User {
+func (c *connection) getUser(ctx context.Context) (*structures.User, error) {
 	var u structures.User
-	err := c.a.users.FindOne(context.TODO(), bson.M{"_id": c.userID}).Decode(&u)
+	err := c.a.users.FindOne(ctx, bson.M{"_id": c.userID}).Decode(&u)
 	if err != nil {
 		log.Printf("Error while retrieving user object (getUser): %s\n", err.Error())
-		c.writeError(mi, structures.ErrorInternal, err)
-		return nil
+		return nil, err
 	}
-	return &u
+	return &u, nil
 }
 
 func (c *connection) loop() {
+	// Connections that never finish logging in are dropped rather than left holding a goroutine and a socket
+	handshakeTimer := time.AfterFunc(time.Duration(c.a.config.WebSocket.HandshakeTimeout)*time.Second, func() {
+		c.conn.Close(websocket.StatusPolicyViolation, "initialization timed out")
+	})
+	// Failed logins return early, the timer mustn't fire on a connection that's already gone
+	defer handshakeTimer.Stop()
 	{
 		var ir structures.InitializationRequest
 		mi, ok := c.readMessage(&ir)
 		if !ok {
 			return
 		}
+		err := c.a.takeRateLimit(c.ctx, loginRateLimitName, ipSubject(c.ip))
+		if err != nil {
+			c.writeError(mi, err)
+			return
+		}
 
 		c.addr = ir.Address
 		c.localizer = i18n.NewLocalizer(c.a.i18nBundle, ir.Locale)
 
-		randBuf := make([]byte, 32)
-		_, err := io.ReadFull(rand.Reader, randBuf)
-		if err != nil {
-			c.writeError(mi, structures.ErrorInternal, err)
+		version, capabilities, ok := negotiateProtocol(&ir)
+		if !ok {
+			c.writeError(mi, newRequestError(structures.ErrorUnsupportedProtocolVersion, "Errors.UnsupportedProtocolVersion", map[string]uint32{
+				"Min": structures.MinProtocolVersion,
+				"Max": structures.ProtocolVersion,
+			}))
 			return
 		}
+		c.protocolVersion = version
+		c.capabilities = make(map[string]struct{}, len(capabilities))
+		for _, capability := range capabilities {
+			c.capabilities[capability] = struct{}{}
+		}
 
-		siweMessage := []byte(c.localizer.MustLocalize(&i18n.LocalizeConfig{
-			MessageID: "General.ChallengeMessage",
-			TemplateData: map[string]string{
-				"Data": base64.StdEncoding.EncodeToString(randBuf),
-			},
-		}))
-
-		var user structures.User
-		err = c.a.users.FindOne(context.TODO(), map[string][20]byte{
-			"addr": ir.Address,
-		}).Decode(&user)
-
-		if err == mongo.ErrNoDocuments {
-			c.writeMessage(true, mi, structures.InitializationResponse{
-				UserFound: false,
-				Challenge: siweMessage,
-			})
-
-			var userCreationReq structures.NewUserInitialization
-			mi, ok := c.readMessage(&userCreationReq)
-			if !ok {
-				return
-			}
-
-			ok, err = sigverify.VerifyEllipticCurveSignature(c.addr, siweMessage, userCreationReq.Signature[:])
-			if !ok {
-				if err != nil {
-					c.writeError(mi, structures.ErrorInvalidSignature, err)
-				} else {
-					c.writeMessage(false, mi, structures.ErrorMessage{
-						Code: structures.ErrorInvalidSignature,
-						Message: c.localizer.MustLocalize(&i18n.LocalizeConfig{
-							MessageID: "Errors.InvalidSignature",
-						}),
-					})
-				}
-				return
-			}
-
-			docsWithSameEmail, err := c.a.users.CountDocuments(context.TODO(), bson.M{
-				"email": userCreationReq.Email,
-			})
-			if err != nil {
-				c.writeError(mi, structures.ErrorInternal, err)
-				return
-			}
-			if docsWithSameEmail != 0 {
-				c.writeMessage(false, mi, structures.ErrorMessage{
-					Code: structures.ErrorInvalidInputs,
-					Message: c.localizer.MustLocalize(&i18n.LocalizeConfig{
-						MessageID: "Errors.AccountWithSameEmail",
-					}),
-				})
-				return
-			}
-
-			user.ID = primitive.NewObjectID()
-			user.CreatedAt = time.Now()
-			user.UpdatedAt = time.Now()
-			user.Email = userCreationReq.Email
-			user.Address = c.addr
-
-			verificationToken := make([]byte, 32)
-			_, err = io.ReadFull(rand.Reader, verificationToken)
-			if err != nil {
-				c.writeError(mi, structures.ErrorInternal, err)
-				return
-			}
-			copy(user.EmailVerificationToken[:], verificationToken)
-			user.EmailVerified = false
-
-			_, err = c.a.users.InsertOne(context.TODO(), user)
-			if err != nil {
-				c.writeError(mi, structures.ErrorInternal, err)
-				return
-			}
-			go c.sendVerificationEmail(&user)
+		var user *structures.User
+		if len(ir.APIKey) != 0 {
+			// API keys skip the challenge entirely, the server answers with Welcome straight away
+			user, ok = c.loginWithAPIKey(mi, ir.APIKey)
 		} else {
-			c.writeMessage(true, mi, structures.InitializationResponse{
-				UserFound: true,
-				Challenge: siweMessage,
-			})
-
-			var ordinaryResponse structures.OrdinaryInitialization
-			mi, ok := c.readMessage(&ordinaryResponse)
-			if !ok {
-				return
-			}
-
-			ok, err = sigverify.VerifyEllipticCurveSignature(c.addr, []byte(siweMessage), ordinaryResponse.Signature[:])
-			if !ok {
-				c.writeError(mi, structures.ErrorInvalidSignature, err)
-				return
-			}
-			c.userID = user.ID
+			user, ok = c.loginWithSignature(mi, &ir, version, capabilities)
+		}
+		if !ok {
+			return
 		}
 
+		usage, err := c.a.usage(c.ctx, user)
+		if err != nil {
+			c.writeError(mi, err)
+			return
+		}
 		c.writeMessage(true, mi, structures.Welcome{
-			Message:  "Welcome!",
-			LoggedIn: true,
+			Message:         "Welcome!",
+			LoggedIn:        true,
+			Quota:           c.a.effectiveQuota(user),
+			Usage:           usage,
+			ProtocolVersion: version,
+			Capabilities:    capabilities,
 			User: structures.User{
 				CreatedAt:             user.CreatedAt,
 				UpdatedAt:             user.UpdatedAt,
@@ -179,9 +152,18 @@ func (c *connection) loop() {
 				Email:                 user.Email,
 				EmailVerified:         user.EmailVerified,
 				EmailVerificationLast: user.EmailVerificationLast,
+				Quota:                 user.Quota,
+				EmailDay:              user.EmailDay,
+				EmailsToday:           user.EmailsToday,
 			},
 		})
 	}
+	// The deferred Stop only runs once the connection is done with
+	handshakeTimer.Stop()
+
+	if c.hasCapability(structures.CapabilityEvents) {
+		go c.forwardEvents()
+	}
 
 	for {
 		mi, buf, ok := c.readMessageInfo()
@@ -189,24 +171,132 @@ func (c *connection) loop() {
 			return
 		}
 
-		switch mi.RequestID {
-		case structures.RequestListFeeds:
-			go c.handleListFeeds(mi, buf)
-		case structures.RequestAddFeed:
-			go c.handleAddFeed(mi, buf)
-		case structures.RequestEditFeed:
-			go c.handleEditFeed(mi, buf)
-		case structures.RequestDeleteFeed:
-			go c.handleDeleteFeed(mi, buf)
-		case structures.RequestEmailVerification:
-			go c.handleEmailVerification(mi, buf)
-		case structures.RequestEmailAgain:
-			go c.handleEmailRequest(mi, buf)
-		default:
-			c.writeMessage(false, mi, structures.ErrorMessage{
-				Code:    structures.ErrorInvalidInputs,
-				Message: "unimplemented request or invalid request",
-			})
+		r, ok := requestRoutes[mi.RequestID]
+		if !ok {
+			c.writeError(mi, errUnknownRequest)
+			continue
 		}
+		if !c.hasScope(r.scope) {
+			c.writeError(mi, errInsufficientScope)
+			continue
+		}
+		// Checked before dispatching, so that a flood doesn't even get to occupy the in-flight slots
+		err := c.takeRateLimit(c.ctx, mi.RequestID)
+		if err != nil {
+			c.writeError(mi, err)
+			continue
+		}
+		c.dispatch(r.serve, mi, buf)
+	}
+}
+
+// loginWithSignature challenges the client to sign a random message with its address, creating the account on the first login
+func (c *connection) loginWithSignature(mi *MessageInfo, ir *structures.InitializationRequest, version uint32, capabilities []string) (*structures.User, bool) {
+	randBuf := make([]byte, 32)
+	_, err := io.ReadFull(rand.Reader, randBuf)
+	if err != nil {
+		c.writeError(mi, err)
+		return nil, false
+	}
+
+	siweMessage := []byte(c.localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "General.ChallengeMessage",
+		TemplateData: map[string]string{
+			"Data": base64.StdEncoding.EncodeToString(randBuf),
+		},
+	}))
+
+	var user structures.User
+	err = c.a.users.FindOne(c.ctx, map[string][20]byte{
+		"addr": ir.Address,
+	}).Decode(&user)
+
+	if err == mongo.ErrNoDocuments {
+		c.writeMessage(true, mi, structures.InitializationResponse{
+			UserFound:       false,
+			Challenge:       siweMessage,
+			ProtocolVersion: version,
+			Capabilities:    capabilities,
+		})
+
+		var userCreationReq structures.NewUserInitialization
+		mi, ok := c.readMessage(&userCreationReq)
+		if !ok {
+			return nil, false
+		}
+
+		ok, err = c.a.sigVerifier.Verify(c.ctx, c.addr, siweMessage, userCreationReq.Signature)
+		if !ok {
+			c.writeError(mi, invalidSignatureError(err))
+			return nil, false
+		}
+
+		docsWithSameEmail, err := c.a.users.CountDocuments(c.ctx, bson.M{
+			"email": userCreationReq.Email,
+		})
+		if err != nil {
+			c.writeError(mi, err)
+			return nil, false
+		}
+		if docsWithSameEmail != 0 {
+			c.writeError(mi, newRequestError(structures.ErrorConflict, "Errors.AccountWithSameEmail", nil))
+			return nil, false
+		}
+
+		user.ID = primitive.NewObjectID()
+		user.CreatedAt = time.Now()
+		user.UpdatedAt = time.Now()
+		user.Email = userCreationReq.Email
+		user.Address = c.addr
+
+		verificationToken := make([]byte, 32)
+		_, err = io.ReadFull(rand.Reader, verificationToken)
+		if err != nil {
+			c.writeError(mi, err)
+			return nil, false
+		}
+		copy(user.EmailVerificationToken[:], verificationToken)
+		user.EmailVerified = false
+
+		_, err = c.a.users.InsertOne(c.ctx, user)
+		if err != nil {
+			c.writeError(mi, err)
+			return nil, false
+		}
+		c.userID = user.ID
+		go c.sendVerificationEmail(&user)
+	} else {
+		c.writeMessage(true, mi, structures.InitializationResponse{
+			UserFound:       true,
+			Challenge:       siweMessage,
+			ProtocolVersion: version,
+			Capabilities:    capabilities,
+		})
+
+		var ordinaryResponse structures.OrdinaryInitialization
+		mi, ok := c.readMessage(&ordinaryResponse)
+		if !ok {
+			return nil, false
+		}
+
+		ok, err = c.a.sigVerifier.Verify(c.ctx, c.addr, siweMessage, ordinaryResponse.Signature)
+		if !ok {
+			c.writeError(mi, invalidSignatureError(err))
+			return nil, false
+		}
+		c.userID = user.ID
+	}
+	return &user, true
+}
+
+func (c *connection) dispatch(h func(*connection, *MessageInfo, []byte), mi *MessageInfo, buf []byte) {
+	select {
+	case c.inFlight <- struct{}{}:
+	case <-c.ctx.Done():
+		return
 	}
+	go func() {
+		defer func() { <-c.inFlight }()
+		h(c, mi, buf)
+	}()
 }
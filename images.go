@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mmcdole/gofeed"
+	smtp "github.com/xhit/go-simple-mail/v2"
+	"golang.org/x/net/html"
+	"golang.org/x/xerrors"
+)
+
+// Images in e-mails are blocked by many mail clients and give the reader's IP away to whoever hosts them. Feeds can have
+// them downloaded and attached instead, or loaded through the backend, which only fetches URLs it signed itself.
+
+const (
+	defaultImageTimeout      = 15
+	defaultImageMaxSize      = 2 << 20
+	defaultImageMaxTotalSize = 10 << 20
+	// Images past this many are left out of an e-mail
+	maxEmailImages = 50
+	// All of an e-mail's images have to be downloaded within this, the notifier waits on them
+	emailImagesTimeout = time.Minute
+)
+
+var errNotAnImage = xerrors.New("not an allowed image type")
+
+// allowedImageTypes are the types the proxy serves and e-mails carry, with the extension inlined images are named with.
+// SVG is left out as it can carry scripts, and proxied images are served from the backend's origin.
+var allowedImageTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+	"image/avif": ".avif",
+}
+
+const imageAccept = "image/avif,image/webp,image/png,image/jpeg,image/gif"
+
+// The HTML part is rebuilt from these elements and attributes only, whatever else is in an item could load something or
+// run. Elements that aren't allowed leave their content behind, unless they're dropped along with it.
+var (
+	allowedEmailElements = map[string]bool{
+		"a": true, "abbr": true, "b": true, "blockquote": true, "br": true, "caption": true, "cite": true, "code": true,
+		"dd": true, "del": true, "div": true, "dl": true, "dt": true, "em": true, "figcaption": true, "figure": true,
+		"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "hr": true, "i": true, "img": true,
+		"ins": true, "kbd": true, "li": true, "mark": true, "ol": true, "p": true, "pre": true, "q": true, "s": true,
+		"samp": true, "small": true, "span": true, "strong": true, "sub": true, "sup": true, "table": true, "tbody": true,
+		"td": true, "tfoot": true, "th": true, "thead": true, "tr": true, "u": true, "ul": true,
+	}
+	droppedEmailElements = map[string]bool{
+		"audio": true, "embed": true, "head": true, "iframe": true, "math": true, "noscript": true, "object": true,
+		"script": true, "select": true, "style": true, "svg": true, "template": true, "textarea": true, "title": true,
+		"video": true,
+	}
+	allowedEmailAttributes = map[string]map[string]bool{
+		"a":    {"href": true, "title": true},
+		"abbr": {"title": true},
+		"img":  {"src": true, "alt": true, "width": true, "height": true, "title": true},
+		"ol":   {"start": true},
+		"td":   {"colspan": true, "rowspan": true},
+		"th":   {"colspan": true, "rowspan": true},
+	}
+)
+
+// downloadImage fetches an image, its type is sniffed from the bytes as sites are sloppy with the Content-Type
+func (a *app) downloadImage(ctx context.Context, link string, maxSize int64) (*fetched, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(a.config.Images.Timeout)*time.Second)
+	defer cancel()
+	img, err := a.fetcher.get(ctx, link, imageAccept, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	img.MediaType = sniffImage(img.Body)
+	if _, ok := allowedImageTypes[img.MediaType]; !ok {
+		return nil, errNotAnImage
+	}
+	return img, nil
+}
+
+// sniffImage is http.DetectContentType, which doesn't know AVIF
+func sniffImage(b []byte) string {
+	if len(b) >= 12 && string(b[4:8]) == "ftyp" && (string(b[8:12]) == "avif" || string(b[8:12]) == "avis") {
+		return "image/avif"
+	}
+	return http.DetectContentType(b)
+}
+
+func (a *app) imageSignature(link string) string {
+	mac := hmac.New(sha256.New, a.imageKey)
+	mac.Write([]byte(link))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (a *app) imageProxyURL(link string) string {
+	return a.config.Images.ProxyURL + "/api/v1/image?" + url.Values{
+		"url": {link},
+		"sig": {a.imageSignature(link)},
+	}.Encode()
+}
+
+// emailHTML is the HTML alternative of an item's e-mail for feeds that don't leave images remote: text is what precedes
+// the body in the plain e-mail, body is the item's HTML. Images that can't be inlined or proxied are replaced with their
+// alt text rather than left remote, and the rest is rebuilt from an allow-list. Items without images go as plain text
+// only.
+func (a *app) emailHTML(feed *structures.Feed, item *gofeed.Item, text, body string) (string, []*smtp.File) {
+	if feed.Images == structures.ImagesRemote {
+		return "", nil
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(body))
+	if err != nil {
+		log.Printf("Failed while parsing the body of %s (%s), failed with %s\n", item.Link, hex.EncodeToString(feed.ID[:]), err.Error())
+		return "", nil
+	}
+	images := doc.Find("img")
+	if images.Length() == 0 {
+		return "", nil
+	}
+
+	mode := feed.Images
+	if mode == structures.ImagesProxy && len(a.config.Images.ProxyURL) == 0 {
+		mode = structures.ImagesInline
+	}
+	// Relative images in the feed's content are relative to the item
+	base, _ := url.Parse(item.Link)
+	var files []*smtp.File
+	// The same image is attached once however often it's used
+	names := make(map[string]string)
+	remaining := a.config.Images.MaxTotalSize
+	ctx, cancel := context.WithTimeout(context.Background(), emailImagesTimeout)
+	defer cancel()
+	images.Each(func(i int, s *goquery.Selection) {
+		src := strings.TrimSpace(s.AttrOr("src", ""))
+		if len(src) != 0 && base != nil {
+			if ref, err := url.Parse(src); err == nil {
+				src = base.ResolveReference(ref).String()
+			}
+		}
+		if i >= maxEmailImages || !validFeedURL(src) {
+			replaceWithAlt(s)
+			return
+		}
+		if mode == structures.ImagesProxy {
+			s.SetAttr("src", a.imageProxyURL(src))
+			return
+		}
+
+		name, ok := names[src]
+		if !ok {
+			limit := min(a.config.Images.MaxSize, remaining)
+			if limit <= 0 || ctx.Err() != nil {
+				replaceWithAlt(s)
+				return
+			}
+			img, err := a.downloadImage(ctx, src, limit)
+			if err != nil {
+				log.Printf("Failed while downloading the image %s (%s), failed with %s\n", src, hex.EncodeToString(feed.ID[:]), err.Error())
+				replaceWithAlt(s)
+				return
+			}
+			name = "image-" + strconv.Itoa(len(files)+1) + allowedImageTypes[img.MediaType]
+			files = append(files, &smtp.File{
+				Name:     name,
+				MimeType: img.MediaType,
+				Data:     img.Body,
+				Inline:   true,
+			})
+			names[src] = name
+			remaining -= int64(len(img.Body))
+		}
+		s.SetAttr("src", "cid:"+name)
+	})
+
+	bldr := new(strings.Builder)
+	bldr.WriteString("<!DOCTYPE html>\n<html><head><meta charset=\"utf-8\"></head><body>\n<p>")
+	bldr.WriteString(strings.ReplaceAll(html.EscapeString(strings.TrimSpace(text)), "\n", "<br>\n"))
+	bldr.WriteString("</p>\n<hr>\n")
+	for _, body := range doc.Find("body").Nodes {
+		for n := body.FirstChild; n != nil; n = n.NextSibling {
+			a.writeEmailNode(bldr, n)
+		}
+	}
+	bldr.WriteString("\n</body></html>\n")
+	return bldr.String(), files
+}
+
+// allowedEmailURL says whether an attribute's URL can stay: images only load what's attached or proxied, links can go
+// anywhere on the web since following them is up to the reader
+func (a *app) allowedEmailURL(attr, value string) bool {
+	value = strings.TrimSpace(value)
+	switch attr {
+	case "src":
+		if strings.HasPrefix(value, "cid:") {
+			return true
+		}
+		return len(a.config.Images.ProxyURL) != 0 && strings.HasPrefix(value, a.config.Images.ProxyURL+"/api/v1/image?")
+	case "href":
+		return validFeedURL(value) || strings.HasPrefix(strings.ToLower(value), "mailto:")
+	}
+	return true
+}
+
+// writeEmailNode writes n with only the allowed elements and attributes, images left with another source become their alt
+// text
+func (a *app) writeEmailNode(bldr *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		bldr.WriteString(html.EscapeString(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+	if droppedEmailElements[n.Data] {
+		return
+	}
+	if !allowedEmailElements[n.Data] {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			a.writeEmailNode(bldr, c)
+		}
+		return
+	}
+
+	attrs := allowedEmailAttributes[n.Data]
+	var kept []html.Attribute
+	for _, attr := range n.Attr {
+		if len(attr.Namespace) != 0 || !attrs[attr.Key] {
+			continue
+		}
+		if (attr.Key == "src" || attr.Key == "href") && !a.allowedEmailURL(attr.Key, attr.Val) {
+			continue
+		}
+		kept = append(kept, attr)
+	}
+	if n.Data == "img" {
+		hasSrc := false
+		for _, attr := range kept {
+			hasSrc = hasSrc || attr.Key == "src"
+		}
+		if !hasSrc {
+			for _, attr := range n.Attr {
+				if attr.Key == "alt" {
+					bldr.WriteString(html.EscapeString(attr.Val))
+				}
+			}
+			return
+		}
+	}
+
+	bldr.WriteByte('<')
+	bldr.WriteString(n.Data)
+	for _, attr := range kept {
+		bldr.WriteByte(' ')
+		bldr.WriteString(attr.Key)
+		bldr.WriteString(`="`)
+		bldr.WriteString(html.EscapeString(attr.Val))
+		bldr.WriteByte('"')
+	}
+	bldr.WriteByte('>')
+	if n.Data == "br" || n.Data == "hr" || n.Data == "img" {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		a.writeEmailNode(bldr, c)
+	}
+	bldr.WriteString("</")
+	bldr.WriteString(n.Data)
+	bldr.WriteByte('>')
+}
+
+func replaceWithAlt(s *goquery.Selection) {
+	s.ReplaceWithHtml(html.EscapeString(s.AttrOr("alt", "")))
+}
+
+// apiImage is the image proxy. Only URLs signed by emailHTML are fetched, so it can't be used to fetch anything else.
+func (a *app) apiImage(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	link := query.Get("url")
+	if !hmac.Equal([]byte(query.Get("sig")), []byte(a.imageSignature(link))) {
+		a.writeAPIFailure(w, r, errNotFound)
+		return
+	}
+	err := a.takeRateLimit(r.Context(), imagesRateLimitName, ipSubject(remoteIP(r.RemoteAddr)))
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+
+	img, err := a.downloadImage(r.Context(), link, a.config.Images.MaxSize)
+	if err != nil {
+		// The image's site failing isn't worth an error body nobody will see
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+	w.Header().Set("Content-Type", img.MediaType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(img.Body)))
+	w.Header().Set("Cache-Control", "public, max-age=604800")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(img.Body)
+}
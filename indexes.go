@@ -24,6 +24,33 @@ func (a *app) EnsureIndexes() error {
 			return err
 		}
 	}
+	{
+		itemsView := a.items.Indexes()
+		_, err := itemsView.CreateMany(context.TODO(), []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "feed_id", Value: 1}, {Key: "key", Value: 1}},
+				Options: options.Index().SetName("archived_item_lookup").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "published", Value: -1}, {Key: "_id", Value: -1}},
+				Options: options.Index().SetName("items_by_owner"),
+			},
+			{
+				Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "feed_id", Value: 1}, {Key: "published", Value: -1}, {Key: "_id", Value: -1}},
+				Options: options.Index().SetName("items_by_feed"),
+			},
+			{
+				// Searches always name the owner, so it prefixes the index. No stemming or stop words, items come in any language.
+				Keys: bson.D{{Key: "owner_id", Value: 1}, {Key: "title", Value: "text"}, {Key: "text", Value: "text"}},
+				Options: options.Index().SetName("item_search").
+					SetWeights(bson.D{{Key: "title", Value: 10}, {Key: "text", Value: 1}}).
+					SetDefaultLanguage("none"),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
 	{
 		usersView := a.users.Indexes()
 		_, err := usersView.CreateMany(context.TODO(), []mongo.IndexModel{
@@ -40,5 +67,74 @@ func (a *app) EnsureIndexes() error {
 			return err
 		}
 	}
+	{
+		feedsView := a.feeds.Indexes()
+		_, err := feedsView.CreateMany(context.TODO(), []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+				Options: options.Index().SetName("feeds_by_owner"),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	{
+		sessionsView := a.sessions.Indexes()
+		_, err := sessionsView.CreateMany(context.TODO(), []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "token_hash", Value: -1}},
+				Options: options.Index().SetName("token_lookup").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetName("session_expiry").SetExpireAfterSeconds(0),
+			},
+			{
+				Keys:    bson.D{{Key: "key_id", Value: 1}},
+				Options: options.Index().SetName("session_key").SetSparse(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	{
+		apiKeysView := a.apiKeys.Indexes()
+		_, err := apiKeysView.CreateMany(context.TODO(), []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "hash", Value: -1}},
+				Options: options.Index().SetName("key_lookup").SetUnique(true),
+			},
+			{
+				Keys:    bson.D{{Key: "user_id", Value: -1}},
+				Options: options.Index().SetName("keys_by_user"),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if a.config.RateLimits.Backend == "mongo" {
+		// A day is plenty for any bucket to be full again, at which point it's no different from a missing one
+		bucketsView := a.buckets.Indexes()
+		_, err := bucketsView.CreateOne(context.TODO(), mongo.IndexModel{
+			Keys:    bson.D{{Key: "updated_at", Value: 1}},
+			Options: options.Index().SetName("bucket_expiry").SetExpireAfterSeconds(86400),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if a.config.Events.Backend == "mongo" {
+		eventLogView := a.eventLog.Indexes()
+		_, err := eventLogView.CreateOne(context.TODO(), mongo.IndexModel{
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("event_expiry").SetExpireAfterSeconds(3600),
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
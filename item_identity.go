@@ -0,0 +1,61 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/mmcdole/gofeed"
+)
+
+var errInvalidIdentity = validationError("identity", "Errors.InvalidIdentity", nil)
+
+// Keys other than GUIDs are prefixed so that they can't collide with one, GUIDs are stored as is so that the items seen
+// before identity strategies existed stay seen
+const (
+	linkKeyPrefix = "link:"
+	hashKeyPrefix = "sha256:"
+)
+
+func linkKey(item *gofeed.Item) string {
+	if len(item.Link) == 0 {
+		return ""
+	}
+	return linkKeyPrefix + item.Link
+}
+
+// hashKey uses the dates as written in the feed, re-parsing them can't change the key that way
+func hashKey(item *gofeed.Item) string {
+	h := sha256.New()
+	for _, part := range []string{item.Title, item.Link, item.Published, item.Updated} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hashKeyPrefix + hex.EncodeToString(h.Sum(nil))
+}
+
+// itemKey decides what identifies an item in seen_items. The strategy's key is used unless the item doesn't have one, then
+// it falls back to the link and finally to the hash of title, link and dates. Only the item itself goes into its key, so
+// items come and go in a feed without changing the keys of the others. Items sharing a key are taken for the same one.
+func itemKey(item *gofeed.Item, strategy uint8) string {
+	switch strategy {
+	case structures.IdentityGUID:
+		if len(item.GUID) != 0 {
+			return item.GUID
+		}
+		fallthrough
+	case structures.IdentityLink:
+		if key := linkKey(item); len(key) != 0 {
+			return key
+		}
+	}
+	return hashKey(item)
+}
+
+func itemKeys(items []*gofeed.Item, strategy uint8) []string {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = itemKey(item, strategy)
+	}
+	return keys
+}
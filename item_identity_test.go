@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/mmcdole/gofeed"
+)
+
+func parseFixture(t *testing.T, name string) []*gofeed.Item {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "identity", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	feed, err := gofeed.NewParser().Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return feed.Items
+}
+
+func assertKeys(t *testing.T, got []string, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d keys, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("key %d is %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestItemKeysMissingGUIDs(t *testing.T) {
+	items := parseFixture(t, "missing_guids.xml")
+	keys := itemKeys(items, structures.IdentityGUID)
+	// Falls back to the link, then to the hash for the item that has neither
+	assertKeys(t, keys, []string{
+		"link:https://example.com/first",
+		"link:https://example.com/second",
+		hashKey(items[2]),
+	})
+	if !strings.HasPrefix(keys[2], hashKeyPrefix) {
+		t.Errorf("key %q isn't a hash", keys[2])
+	}
+}
+
+func TestItemKeysDuplicateGUIDs(t *testing.T) {
+	items := parseFixture(t, "duplicate_guids.xml")
+	keys := itemKeys(items, structures.IdentityGUID)
+	// A shared GUID is still the GUID, the feed says they're the same item
+	assertKeys(t, keys, []string{"post", "post", "post", "unique"})
+
+	// Keys don't depend on the other items of the fetch, a sibling coming or going doesn't make an item new again
+	for i, item := range items {
+		for _, strategy := range []uint8{structures.IdentityGUID, structures.IdentityLink, structures.IdentityHash} {
+			alone := itemKeys(items[i:i+1], strategy)[0]
+			if want := itemKeys(items, strategy)[i]; alone != want {
+				t.Errorf("item %d with strategy %d is %q alone, %q in the feed", i, strategy, alone, want)
+			}
+			if got := itemKey(item, strategy); got != alone {
+				t.Errorf("item %d with strategy %d is %q, want %q", i, strategy, got, alone)
+			}
+		}
+	}
+}
+
+func TestItemKeysRegeneratedGUIDs(t *testing.T) {
+	first := parseFixture(t, "regenerated_guids_1.xml")
+	second := parseFixture(t, "regenerated_guids_2.xml")
+
+	byGUID := itemKeys(first, structures.IdentityGUID)
+	if again := itemKeys(second, structures.IdentityGUID); byGUID[0] == again[0] {
+		t.Fatal("fixture GUIDs don't differ between builds")
+	}
+
+	for _, strategy := range []uint8{structures.IdentityLink, structures.IdentityHash} {
+		before := itemKeys(first, strategy)
+		after := itemKeys(second, strategy)
+		assertKeys(t, after, before)
+		if before[0] == before[1] {
+			t.Errorf("strategy %d gave both items the key %q", strategy, before[0])
+		}
+	}
+	assertKeys(t, itemKeys(first, structures.IdentityLink), []string{
+		"link:https://example.com/first",
+		"link:https://example.com/second",
+	})
+}
+
+func TestItemKeysIdenticalItems(t *testing.T) {
+	item := &gofeed.Item{Title: "Same", Published: "2026-10-05T10:00:00Z"}
+	keys := itemKeys([]*gofeed.Item{item, item}, structures.IdentityGUID)
+	// Listed twice, it's still one item
+	assertKeys(t, keys, []string{hashKey(item), hashKey(item)})
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// minimumChangedWords keeps typo fixes from being mailed as updates, title changes always count
+	minimumChangedWords = 3
+	// maxDiffCells bounds the word diff's table, about 512 KiB as feeds are checked concurrently. Past it only the fact that
+	// the content changed is mailed.
+	maxDiffCells = 1 << 16
+)
+
+// itemContent is what updates are detected on, the markup and whitespace don't matter
+type itemContent struct {
+	title string
+	text  string
+	hash  string
+}
+
+func newItemContent(item *gofeed.Item) itemContent {
+	body := item.Content
+	if len(body) == 0 {
+		body = item.Description
+	}
+	c := itemContent{
+		title: strings.Join(strings.Fields(item.Title), " "),
+		text:  plainText(body),
+	}
+	h := sha256.Sum256([]byte(c.title + "\x00" + c.text))
+	c.hash = hex.EncodeToString(h[:])
+	return c
+}
+
+// plainText drops the tags, scripts and styles of an HTML fragment and collapses the whitespace, plain text goes through as is
+func plainText(fragment string) string {
+	z := html.NewTokenizer(strings.NewReader(fragment))
+	var words []string
+	skip := 0
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return strings.Join(words, " ")
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			if a := atom.Lookup(name); a == atom.Script || a == atom.Style {
+				skip++
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if a := atom.Lookup(name); (a == atom.Script || a == atom.Style) && skip != 0 {
+				skip--
+			}
+		case html.TextToken:
+			if skip == 0 {
+				words = append(words, strings.Fields(string(z.Text()))...)
+			}
+		}
+	}
+}
+
+// wordDiff marks the words removed from old as [-…-] and the ones added in new as {+…+}, the count is of the changed words.
+// The words both texts start and end with are left out of the table, ok is false if what's changed in between is still too
+// large to diff.
+func wordDiff(old, new string) (changes string, changed int, ok bool) {
+	a, b := strings.Fields(old), strings.Fields(new)
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	out := append([]string(nil), a[:prefix]...)
+	common := a[len(a)-suffix:]
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(a)*len(b) > maxDiffCells {
+		return "", len(a) + len(b), false
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var removed, added []string
+	flush := func() {
+		if len(removed) != 0 {
+			out = append(out, "[-"+strings.Join(removed, " ")+"-]")
+		}
+		if len(added) != 0 {
+			out = append(out, "{+"+strings.Join(added, " ")+"+}")
+		}
+		changed += len(removed) + len(added)
+		removed, added = nil, nil
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			out = append(out, a[i])
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, a[i])
+			i++
+		default:
+			added = append(added, b[j])
+			j++
+		}
+	}
+	flush()
+	out = append(out, common...)
+	return strings.Join(out, " "), changed, true
+}
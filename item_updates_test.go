@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWordDiff(t *testing.T) {
+	changes, changed, ok := wordDiff("the quick brown fox jumps", "the slow brown fox leaps high")
+	if !ok {
+		t.Fatal("a small change wasn't diffed")
+	}
+	if want := "the [-quick-] {+slow+} brown fox [-jumps-] {+leaps high+}"; changes != want {
+		t.Errorf("got %q, want %q", changes, want)
+	}
+	if changed != 5 {
+		t.Errorf("got %d changed words, want 5", changed)
+	}
+}
+
+func TestWordDiffLargeTexts(t *testing.T) {
+	words := make([]string, 20000)
+	for i := range words {
+		words[i] = "w" + strings.Repeat("x", i%7)
+	}
+	old := strings.Join(words, " ")
+
+	// The shared start and end stay out of the table, a small change in a long text is still diffed
+	changes, changed, ok := wordDiff(old, strings.Replace(old, "wxxx", "changed", 1))
+	if !ok || changed != 2 || !strings.Contains(changes, "[-wxxx-] {+changed+}") {
+		t.Errorf("got ok %t and %d changed words", ok, changed)
+	}
+
+	// A rewrite of all of it is too large to diff
+	_, _, ok = wordDiff(old, strings.ReplaceAll(old, "w", "v"))
+	if ok {
+		t.Error("a rewrite of a long text was diffed")
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/mmcdole/gofeed"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultItemPageSize = 50
+	maxItemPageSize     = 500
+)
+
+var errTooManyItemIDs = validationError("ids", "Errors.TooManyItemIDs", map[string]int{
+	"Max": structures.MaxItemIDs,
+})
+
+// itemContinuation works like feedContinuation, items are always listed newest first
+type itemContinuation struct {
+	FeedID        primitive.ObjectID `bson:"f"`
+	UnreadOnly    bool               `bson:"u"`
+	StarredOnly   bool               `bson:"s"`
+	LastPublished time.Time          `bson:"p"`
+	LastID        primitive.ObjectID `bson:"id"`
+}
+
+func itemAuthor(item *gofeed.Item) string {
+	if item.Author != nil {
+		return item.Author.Name
+	}
+	for _, author := range item.Authors {
+		if author != nil && len(author.Name) != 0 {
+			return author.Name
+		}
+	}
+	return ""
+}
+
+func itemEnclosures(item *gofeed.Item) []structures.Enclosure {
+	enclosures := make([]structures.Enclosure, 0, len(item.Enclosures))
+	for _, e := range item.Enclosures {
+		if e == nil || len(e.URL) == 0 {
+			continue
+		}
+		length, _ := strconv.ParseUint(e.Length, 10, 64)
+		enclosures = append(enclosures, structures.Enclosure{
+			URL:    e.URL,
+			Type:   e.Type,
+			Length: length,
+		})
+	}
+	return enclosures
+}
+
+// archiveItems keeps the latest version of every fetched item, read and starred states survive the item changing. The
+// items that weren't in the archive yet are flagged, items sharing a key are archived once, as the first of them. When
+// only some of the writes fail, the flags of the others are still returned along with the error.
+func (a *app) archiveItems(ctx context.Context, feed *structures.Feed, items []*gofeed.Item, keys []string, fetchedAt time.Time) ([]bool, error) {
+	inserted := make([]bool, len(items))
+	if len(items) == 0 {
+		return inserted, nil
+	}
+	models := make([]mongo.WriteModel, 0, len(items))
+	// Which item each model is for
+	modelItems := make([]int, 0, len(items))
+	archived := make(map[string]struct{}, len(items))
+	for i, item := range items {
+		if _, ok := archived[keys[i]]; ok {
+			continue
+		}
+		archived[keys[i]] = struct{}{}
+		modelItems = append(modelItems, i)
+		content := item.Content
+		if len(content) == 0 {
+			content = item.Description
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{
+				"feed_id": feed.ID,
+				"key":     keys[i],
+			}).
+			SetUpdate(bson.M{
+				"$set": bson.M{
+					"title":      item.Title,
+					"link":       item.Link,
+					"author":     itemAuthor(item),
+					"content":    content,
+					"text":       plainText(content),
+					"enclosures": itemEnclosures(item),
+					"updated":    updatedDate(item),
+				},
+				"$setOnInsert": bson.M{
+					"_id":        primitive.NewObjectID(),
+					"owner_id":   feed.Owner,
+					"published":  itemDate(item, fetchedAt),
+					"fetched_at": fetchedAt,
+					"read":       false,
+					"starred":    false,
+				},
+			}).
+			SetUpsert(true))
+	}
+	result, err := a.items.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	if err != nil && !errors.As(err, &bulkErr) {
+		return nil, err
+	}
+	if result != nil {
+		for i := range result.UpsertedIDs {
+			inserted[modelItems[i]] = true
+		}
+	}
+	return inserted, err
+}
+
+func (a *app) listItems(ctx context.Context, userID primitive.ObjectID, req *structures.ListItemsRequest) (*structures.ListItemsResponse, error) {
+	pageSize := int64(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultItemPageSize
+	}
+	if pageSize > maxItemPageSize {
+		pageSize = maxItemPageSize
+	}
+
+	filter := bson.M{
+		"owner_id": userID,
+	}
+	if !req.FeedID.IsZero() {
+		// Saved searches list what they matched
+		filter["$or"] = bson.A{
+			bson.M{"feed_id": req.FeedID},
+			bson.M{"searches": req.FeedID},
+		}
+	}
+	if req.UnreadOnly {
+		filter["read"] = false
+	}
+	if req.StarredOnly {
+		filter["starred"] = true
+	}
+
+	total, err := a.items.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pageFilter := filter
+	if len(req.Continuation) != 0 {
+		raw, err := base64.RawURLEncoding.DecodeString(req.Continuation)
+		if err != nil {
+			return nil, errInvalidContinuation
+		}
+		var cont itemContinuation
+		err = bson.Unmarshal(raw, &cont)
+		if err != nil || cont.FeedID != req.FeedID || cont.UnreadOnly != req.UnreadOnly || cont.StarredOnly != req.StarredOnly {
+			return nil, errInvalidContinuation
+		}
+		pageFilter = bson.M{
+			"$and": bson.A{
+				filter,
+				bson.M{"$or": bson.A{
+					bson.M{"published": bson.M{"$lt": cont.LastPublished}},
+					bson.M{"published": cont.LastPublished, "_id": bson.M{"$lt": cont.LastID}},
+				}},
+			},
+		}
+	}
+
+	var items []structures.Item
+	// One extra to know whether there's another page
+	cursor, err := a.items.Find(ctx, pageFilter, options.Find().
+		SetSort(bson.D{{Key: "published", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(pageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &items)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []structures.Item{}
+	}
+
+	resp := &structures.ListItemsResponse{
+		Count: uint64(total),
+		Items: items,
+	}
+	if int64(len(items)) > pageSize {
+		items = items[:pageSize]
+		last := &items[len(items)-1]
+		raw, err := bson.Marshal(itemContinuation{
+			FeedID:        req.FeedID,
+			UnreadOnly:    req.UnreadOnly,
+			StarredOnly:   req.StarredOnly,
+			LastPublished: last.Published,
+			LastID:        last.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Items = items
+		resp.Continuation = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return resp, nil
+}
+
+func (a *app) markItems(ctx context.Context, userID primitive.ObjectID, req *structures.MarkItemsRequest) (*structures.UpdatedItemsResponse, error) {
+	filter := bson.M{
+		"owner_id": userID,
+	}
+	switch {
+	case req.All && !req.FeedID.IsZero():
+		filter["$or"] = bson.A{
+			bson.M{"feed_id": req.FeedID},
+			bson.M{"searches": req.FeedID},
+		}
+	case req.All:
+	case len(req.IDs) > structures.MaxItemIDs:
+		return nil, errTooManyItemIDs
+	case len(req.IDs) == 0:
+		return &structures.UpdatedItemsResponse{}, nil
+	default:
+		filter["_id"] = bson.M{"$in": req.IDs}
+	}
+	result, err := a.items.UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{
+			"read": req.Read,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &structures.UpdatedItemsResponse{
+		ModifiedCount: uint64(result.ModifiedCount),
+	}, nil
+}
+
+func (a *app) starItems(ctx context.Context, userID primitive.ObjectID, req *structures.StarItemsRequest) (*structures.UpdatedItemsResponse, error) {
+	if len(req.IDs) > structures.MaxItemIDs {
+		return nil, errTooManyItemIDs
+	}
+	if len(req.IDs) == 0 {
+		return &structures.UpdatedItemsResponse{}, nil
+	}
+	result, err := a.items.UpdateMany(ctx, bson.M{
+		"owner_id": userID,
+		"_id":      bson.M{"$in": req.IDs},
+	}, bson.M{
+		"$set": bson.M{
+			"starred": req.Starred,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &structures.UpdatedItemsResponse{
+		ModifiedCount: uint64(result.ModifiedCount),
+	}, nil
+}
+
+func (c *connection) handleListItems(ctx context.Context, req *structures.ListItemsRequest) (*structures.ListItemsResponse, error) {
+	return c.a.listItems(ctx, c.userID, req)
+}
+
+func (c *connection) handleMarkItems(ctx context.Context, req *structures.MarkItemsRequest) (*structures.UpdatedItemsResponse, error) {
+	return c.a.markItems(ctx, c.userID, req)
+}
+
+func (c *connection) handleStarItems(ctx context.Context, req *structures.StarItemsRequest) (*structures.UpdatedItemsResponse, error) {
+	return c.a.starItems(ctx, c.userID, req)
+}
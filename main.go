@@ -2,11 +2,16 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"embed"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
+	"git.maharshi.ninja/root/rss2email/structures"
 	"github.com/BurntSushi/toml"
 	"github.com/caddyserver/certmagic"
 	feed "github.com/mmcdole/gofeed"
@@ -30,6 +35,77 @@ type Configuration struct {
 	BaseURL        string
 	NotifyOldItems bool
 
+	Ethereum struct {
+		// JSON-RPC endpoint used to verify smart-contract wallet signatures, leave empty to only accept EOA signatures
+		RPCURL string
+	}
+
+	Events struct {
+		// "local" (default) only reaches clients connected to this instance, "mongo" uses a change stream and needs a replica set
+		Backend string
+	}
+
+	WebSocket struct {
+		// Host patterns (e.g. "app.example.com", "*.example.com") of the pages allowed to open websockets, BaseURL's host if empty.
+		// Clients that don't send an Origin header, i.e. anything but browsers, aren't affected.
+		AllowedOrigins []string
+		// In bytes, 1 MiB if unset. Also caps the bodies of HTTP API requests.
+		MaxMessageSize int64
+		// In seconds, connections that haven't logged in by then are closed, 30 if unset
+		HandshakeTimeout uint
+	}
+
+	// Defaults for the per-user quotas, a user's own values take precedence
+	Quota struct {
+		MaxFeeds uint32
+		// In seconds
+		MinimumFrequency uint
+		MaxEmailsPerDay  uint32
+	}
+
+	RateLimits struct {
+		// "memory" (default) only limits what reaches this instance, "mongo" shares the limits between instances
+		Backend string
+		// For every request without its own limit
+		Default rateLimit
+		// Keyed by request name (e.g. "AddFeed", see structures.Requests, or "Me" for the HTTP API's /me), "Login" for initialization, "Output" for the feed output or "Images" for the image proxy
+		Requests map[string]rateLimit
+	}
+
+	// Downloading articles for feeds in full-text mode
+	FullText struct {
+		// In seconds, 15 if unset
+		Timeout uint
+		// In bytes, 2 MiB if unset
+		MaxSize int64
+		// Downloads running against one site at a time, enclosures included, 2 if unset
+		PerHost int
+	}
+
+	// Downloading enclosures for feeds that attach them
+	Enclosures struct {
+		// In seconds, for each download, 60 if unset
+		Timeout uint
+		// In bytes, larger enclosures are only linked, 10 MiB if unset
+		MaxSize int64
+		// In bytes, for all of an e-mail's attachments together, 20 MiB if unset
+		MaxTotalSize int64
+	}
+
+	// Inlining or proxying the images of feeds that don't leave them remote
+	Images struct {
+		// In seconds, for each download, 15 if unset
+		Timeout uint
+		// In bytes, larger images are left out, 2 MiB if unset
+		MaxSize int64
+		// In bytes, for all of an e-mail's inlined images together, 10 MiB if unset
+		MaxTotalSize int64
+		// The backend's public URL (e.g. "https://api.example.com"), feeds that proxy images get them inlined if unset
+		ProxyURL string
+		// Signs proxied image URLs, random on every start if unset, which breaks the images of e-mails sent before
+		ProxySecret string
+	}
+
 	LetsEncrypt struct {
 		Enable  bool
 		Email   string
@@ -50,15 +126,32 @@ type Configuration struct {
 type app struct {
 	config      *Configuration
 	codecHandle *codec.MsgpackHandle
+	jsonHandle  *codec.JsonHandle
 	i18nBundle  *i18n.Bundle
 	emailClient *smtp.SMTPServer
 	feedParser  *feed.Parser
+	sigVerifier SignatureVerifier
+	events      EventBroker
+	rateLimiter RateLimiter
+	fetcher     *fetcher
+	extractor   *articleExtractor
+	// HMAC key of the image proxy
+	imageKey []byte
+	// What withBearer limits the HTTP API's routes under, some of them have no websocket request
+	apiRateLimitNames map[string]struct{}
 
 	conn      *mongo.Client
 	database  *mongo.Database
 	users     *mongo.Collection
 	feeds     *mongo.Collection
 	seenItems *mongo.Collection
+	items     *mongo.Collection
+	eventLog  *mongo.Collection
+	sessions  *mongo.Collection
+	apiKeys   *mongo.Collection
+	buckets   *mongo.Collection
+
+	openAPIDocument []byte
 }
 
 func main() {
@@ -78,6 +171,22 @@ func main() {
 		a.config = c
 	}
 
+	{
+		if len(a.config.WebSocket.AllowedOrigins) == 0 {
+			u, err := url.Parse(a.config.BaseURL)
+			if err != nil {
+				panic(err)
+			}
+			a.config.WebSocket.AllowedOrigins = []string{u.Host}
+		}
+		if a.config.WebSocket.MaxMessageSize == 0 {
+			a.config.WebSocket.MaxMessageSize = defaultMaxMessageSize
+		}
+		if a.config.WebSocket.HandshakeTimeout == 0 {
+			a.config.WebSocket.HandshakeTimeout = defaultHandshakeTimeout
+		}
+	}
+
 	{
 		cl, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(a.config.MongoURL))
 		if err != nil {
@@ -87,10 +196,16 @@ func main() {
 		if err != nil {
 			panic(err)
 		}
+		a.conn = cl
 		a.database = cl.Database(a.config.MongoDBName)
 		a.users = a.database.Collection("users")
 		a.feeds = a.database.Collection("feeds")
 		a.seenItems = a.database.Collection("seen_items")
+		a.items = a.database.Collection("items")
+		a.eventLog = a.database.Collection("events")
+		a.sessions = a.database.Collection("sessions")
+		a.apiKeys = a.database.Collection("api_keys")
+		a.buckets = a.database.Collection("rate_limits")
 	}
 
 	{
@@ -99,6 +214,17 @@ func main() {
 		msgpHandle.ReaderBufferSize = 8192
 		msgpHandle.WriteExt = true
 		a.codecHandle = msgpHandle
+
+		// Used by the HTTP API, follows the same codec tags so both protocols share field names
+		a.jsonHandle = new(codec.JsonHandle)
+	}
+
+	{
+		doc, err := buildOpenAPI(a.config.BaseURL)
+		if err != nil {
+			panic(err)
+		}
+		a.openAPIDocument = doc
 	}
 
 	{
@@ -121,7 +247,132 @@ func main() {
 	}
 
 	{
+		if a.config.FullText.Timeout == 0 {
+			a.config.FullText.Timeout = defaultFullTextTimeout
+		}
+		if a.config.FullText.MaxSize == 0 {
+			a.config.FullText.MaxSize = defaultFullTextMaxSize
+		}
+		if a.config.FullText.PerHost == 0 {
+			a.config.FullText.PerHost = defaultFullTextPerHost
+		}
+		if a.config.Enclosures.Timeout == 0 {
+			a.config.Enclosures.Timeout = defaultEnclosureTimeout
+		}
+		if a.config.Enclosures.MaxSize == 0 {
+			a.config.Enclosures.MaxSize = defaultEnclosureMaxSize
+		}
+		if a.config.Enclosures.MaxTotalSize == 0 {
+			a.config.Enclosures.MaxTotalSize = defaultEnclosureMaxTotalSize
+		}
+		a.fetcher = newFetcher(a.config.FullText.PerHost)
+		// Feeds are fetched with the same client, their URLs are the ones users pick outright
 		a.feedParser = feed.NewParser()
+		a.feedParser.Client = a.fetcher.client
+		a.extractor = &articleExtractor{
+			fetcher: a.fetcher,
+			timeout: time.Duration(a.config.FullText.Timeout) * time.Second,
+			maxSize: a.config.FullText.MaxSize,
+		}
+	}
+
+	{
+		if a.config.Images.Timeout == 0 {
+			a.config.Images.Timeout = defaultImageTimeout
+		}
+		if a.config.Images.MaxSize == 0 {
+			a.config.Images.MaxSize = defaultImageMaxSize
+		}
+		if a.config.Images.MaxTotalSize == 0 {
+			a.config.Images.MaxTotalSize = defaultImageMaxTotalSize
+		}
+		a.config.Images.ProxyURL = strings.TrimSuffix(a.config.Images.ProxyURL, "/")
+		if len(a.config.Images.ProxySecret) != 0 {
+			a.imageKey = []byte(a.config.Images.ProxySecret)
+		} else {
+			a.imageKey = make([]byte, 32)
+			_, err := rand.Read(a.imageKey)
+			if err != nil {
+				panic(err)
+			}
+			if len(a.config.Images.ProxyURL) != 0 {
+				log.Println("No image proxy secret configured, proxied images of e-mails sent before a restart won't load.")
+			}
+		}
+	}
+
+	{
+		verifiers := chainedVerifier{ecdsaVerifier{}}
+		if len(a.config.Ethereum.RPCURL) != 0 {
+			v, err := newEIP1271Verifier(a.config.Ethereum.RPCURL)
+			if err != nil {
+				panic(err)
+			}
+			verifiers = append(verifiers, v)
+		}
+		a.sigVerifier = verifiers
+	}
+
+	{
+		switch a.config.Events.Backend {
+		case "", "local":
+			a.events = newLocalBroker()
+		case "mongo":
+			b := newMongoBroker(a.eventLog)
+			go b.watch(context.Background())
+			a.events = b
+		default:
+			panic("unknown events backend: " + a.config.Events.Backend)
+		}
+	}
+
+	{
+		if a.config.Quota.MaxFeeds == 0 {
+			a.config.Quota.MaxFeeds = defaultMaxFeeds
+		}
+		if a.config.Quota.MinimumFrequency == 0 {
+			a.config.Quota.MinimumFrequency = uint(MinimumFrequency / time.Second)
+		}
+		if a.config.Quota.MaxEmailsPerDay == 0 {
+			a.config.Quota.MaxEmailsPerDay = defaultMaxEmailsPerDay
+		}
+	}
+
+	// Before the rate limits are checked, the HTTP API's routes name theirs
+	http.HandleFunc("/", a.handler)
+	a.registerAPI(http.DefaultServeMux)
+
+	{
+		if a.config.RateLimits.Default == (rateLimit{}) {
+			a.config.RateLimits.Default = defaultRateLimit
+		}
+		if !a.config.RateLimits.Default.valid() {
+			panic("the default rate limit needs a positive Rate and a Burst of at least 1")
+		}
+		for name, limit := range a.config.RateLimits.Requests {
+			known := name == loginRateLimitName || name == outputRateLimitName || name == imagesRateLimitName
+			for _, r := range structures.Requests {
+				known = known || r.Name == name
+			}
+			_, isAPI := a.apiRateLimitNames[name]
+			if !known && !isAPI {
+				panic("rate limit for unknown request: " + name)
+			}
+			if !limit.valid() {
+				panic("rate limit for " + name + " needs a positive Rate and a Burst of at least 1")
+			}
+		}
+
+		switch a.config.RateLimits.Backend {
+		case "", "memory":
+			l := newMemoryLimiter()
+			go l.sweep(10 * time.Minute)
+			a.rateLimiter = l
+		case "mongo":
+			a.rateLimiter = &mongoLimiter{coll: a.buckets}
+		default:
+			panic("unknown rate limit backend: " + a.config.RateLimits.Backend)
+		}
 	}
 
 	{
@@ -129,11 +380,14 @@ func main() {
 		if err != nil {
 			panic(err)
 		}
+		err = a.backfillFeeds(context.TODO())
+		if err != nil {
+			panic(err)
+		}
 	}
 	go a.notificationLoop()
 
 	log.Println("All initialized, listening.")
-	http.HandleFunc("/", a.handler)
 	if a.config.LetsEncrypt.Enable {
 		certmagic.DefaultACME.Agreed = true
 		certmagic.DefaultACME.Email = a.config.LetsEncrypt.Email
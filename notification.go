@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
+	"errors"
 	"log"
 	"math"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -14,10 +17,15 @@ import (
 	smtp "github.com/xhit/go-simple-mail/v2"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/xerrors"
 )
 
+// errEmailQuotaUsedUp stops a feed's items from being gone through, the rest are looked at again once the quota resets
+var errEmailQuotaUsedUp = xerrors.New("daily email quota used up")
+
 var (
 	tickerTime           = 10 * time.Minute
 	tickerTimeHalf       = tickerTime / 2
@@ -51,10 +59,90 @@ func (a *app) sendEmailForItem(feed *structures.Feed, user *structures.User, ite
 			bldr.WriteRune('\n')
 		}
 	}
+	attachments, attached := a.enclosureAttachments(feed, item)
+	writeEnclosures(bldr, item, attached)
 	bldr.WriteString("\n\n")
-	bldr.WriteString(item.Content)
-	body := bldr.String()
+	body := a.itemBody(feed, item)
+	html, images := a.emailHTML(feed, item, bldr.String(), body)
+	bldr.WriteString(body)
+
+	return a.sendEmail(user, subject, bldr.String(), html, append(attachments, images...)...)
+}
+
+// itemBody is what the feed has for the item, or the article itself for feeds in full-text mode. Articles that can't be
+// downloaded or made sense of fall back to the feed's content.
+func (a *app) itemBody(feed *structures.Feed, item *gofeed.Item) string {
+	if feed.FullText && len(item.Link) != 0 {
+		article, err := a.extractor.Extract(context.TODO(), item.Link)
+		if err == nil {
+			return article
+		}
+		log.Printf("Failed while extracting the article of %s (%s), failed with %s\n", item.Link, hex.EncodeToString(feed.ID[:]), err.Error())
+	}
+	return item.Content
+}
+
+// sendSearchEmailForItem is sendEmailForItem for an item found by saved searches
+func (a *app) sendSearchEmailForItem(searches []*structures.Feed, feed *structures.Feed, user *structures.User, item *gofeed.Item) error {
+	names := make([]string, 0, len(searches))
+	for _, search := range searches {
+		names = append(names, search.Name)
+	}
+
+	bldr := new(strings.Builder)
+	bldr.WriteString("New match for ")
+	bldr.WriteString(strings.Join(names, ", "))
+	bldr.WriteString(": ")
+	bldr.WriteString(item.Title)
+
+	subject := bldr.String()
+
+	bldr.WriteString("\nFrom: ")
+	bldr.WriteString(feed.Name)
+	bldr.WriteString("\nURL: ")
+	bldr.WriteString(item.Link)
+	bldr.WriteRune('\n')
+	attachments, attached := a.enclosureAttachments(feed, item)
+	writeEnclosures(bldr, item, attached)
+	bldr.WriteString("\n\n")
+	body := a.itemBody(feed, item)
+	html, images := a.emailHTML(feed, item, bldr.String(), body)
+	bldr.WriteString(body)
+
+	return a.sendEmail(user, subject, bldr.String(), html, append(attachments, images...)...)
+}
+
+// sendUpdateEmailForItem tells what changed in an item that was already e-mailed, in the style of git's word diff unless
+// the change was too large to diff
+func (a *app) sendUpdateEmailForItem(feed *structures.Feed, user *structures.User, item *gofeed.Item, seen *structures.SeenItem, content itemContent, changes string, diffed bool) error {
+	bldr := new(strings.Builder)
+	bldr.WriteString("Updated post on ")
+	bldr.WriteString(feed.Name)
+	bldr.WriteString(": ")
+	bldr.WriteString(item.Title)
+
+	subject := bldr.String()
+
+	bldr.WriteString("\nURL: ")
+	bldr.WriteString(item.Link)
+	bldr.WriteRune('\n')
+	if seen.Title != content.title {
+		bldr.WriteString("Previous title: ")
+		bldr.WriteString(seen.Title)
+		bldr.WriteRune('\n')
+	}
+	if !diffed {
+		bldr.WriteString("\nThe content changed too much to show what changed, the link has the current version.\n")
+	} else {
+		bldr.WriteString("\nRemoved text is marked [-like this-], added text {+like this+}.\n\n")
+		bldr.WriteString(changes)
+	}
+
+	return a.sendEmail(user, subject, bldr.String(), "")
+}
 
+// sendEmail sends body as plain text, along with html as an alternative if it isn't empty
+func (a *app) sendEmail(user *structures.User, subject, body, html string, attachments ...*smtp.File) error {
 	msg := smtp.NewMSG()
 	msg.SetFrom(a.config.EmailConfig.FromAddr)
 	msg.SetSubject(subject)
@@ -63,6 +151,15 @@ func (a *app) sendEmailForItem(feed *structures.Feed, user *structures.User, ite
 		return err
 	}
 	msg.SetBody(smtp.TextPlain, body)
+	if len(html) != 0 {
+		msg.AddAlternative(smtp.TextHTML, html)
+	}
+	for _, file := range attachments {
+		msg.Attach(file)
+	}
+	if msg.Error != nil {
+		return msg.Error
+	}
 
 	conn, err := a.emailClient.Connect()
 	if err != nil {
@@ -125,61 +222,145 @@ func (a *app) onNotificationTick(tym time.Time) {
 				continue
 			}
 
-			diff := time.Duration(timeUnix) % feedDoc.Frequency
+			// Saved searches have nothing to fetch, they're fed by the other feeds
+			if len(feedDoc.Query) != 0 {
+				continue
+			}
+
+			owner := feedDoc.OwnerList[0]
+			// The quota may have been lowered since the feed was added
+			frequency := max(feedDoc.Frequency, a.effectiveQuota(owner).MinimumFrequency)
+			diff := time.Duration(timeUnix) % frequency
 			// If diff is greater than tickerTime or less than -tickerTime, skip because it's not relevant
 			if diff >= tickerTime || diff <= -tickerTime {
 				continue
 			}
 
-			if !feedDoc.OwnerList[0].EmailVerified {
+			if !owner.EmailVerified {
 				continue
 			}
 
 			grp.Go(func() error {
 				feed, err := a.feedParser.ParseURL(feedDoc.URL)
 				if err != nil {
-					log.Printf("Couldn't fetch %s\n", hex.EncodeToString(feedDoc.ID[:]))
+					log.Printf("Couldn't fetch %s, failed with %s\n", hex.EncodeToString(feedDoc.ID[:]), err.Error())
+					a.markFeedFailing(feedDoc.Feed, err)
 					return nil // doesn't need to interrupt the fetching
 				}
+				var newItems, updatedItems uint64
+
+				now := time.Now()
+				firstFetched := feedDoc.FirstFetched
+				// Feeds fetched before first_fetched existed had their old items filtered by creation time
+				if firstFetched.IsZero() && feedDoc.LastFetched.After(time.Unix(0, 0)) {
+					firstFetched = feedDoc.CreatedAt
+				}
+				// The first successful fetch only records what the feed already has, unless NotifyOldItems is set to true. So
+				// does the first one after the identity changed, the items were delivered under their old keys.
+				baseline := (firstFetched.IsZero() && !a.config.NotifyOldItems) || feedDoc.Rebaseline
+
+				// Do NOT report items from before the feed's first successful fetch unless NotifyOldItems is set to true
+				old := func(item *gofeed.Item) bool {
+					return !firstFetched.IsZero() && itemDate(item, now).Before(firstFetched) && a.config.NotifyOldItems == false
+				}
 
-				for _, item := range feed.Items {
-					// Do NOT report items created before the feed creation unless NotifyOldItems is set to true
-					if feedDoc.CreatedAt.After(*item.PublishedParsed) && a.config.NotifyOldItems == false {
+				keys := itemKeys(feed.Items, feedDoc.Identity)
+				inserted, err := a.archiveItems(context.TODO(), feedDoc.Feed, feed.Items, keys, now)
+				if err != nil {
+					log.Printf("Failed while archiving the items of %s, failed with %s\n", hex.EncodeToString(feedDoc.ID[:]), err.Error())
+				}
+				// Saved searches only look at what's new to the archive, so every item goes through them once
+				var fresh []*gofeed.Item
+				var freshKeys []string
+				for i, item := range feed.Items {
+					if inserted != nil && inserted[i] && !baseline && !old(item) {
+						fresh = append(fresh, item)
+						freshKeys = append(freshKeys, keys[i])
+					}
+				}
+
+				for i, item := range feed.Items {
+					key := keys[i]
+					if old(item) {
 						continue
 					}
 
-					exists, err := a.seenItems.CountDocuments(context.TODO(), bson.M{
+					var seen structures.SeenItem
+					err := a.seenItems.FindOne(context.TODO(), bson.M{
 						"feed_id": feedDoc.ID,
-						"guid":    item.GUID,
-					})
-					if err != nil {
-						log.Printf("Tried to find %s (%s), failed with %s\n", item.GUID, hex.EncodeToString(feedDoc.ID[:]), err.Error())
+						"guid":    key,
+					}).Decode(&seen)
+					if err == nil {
+						updated, err := a.checkItemUpdate(feedDoc.Feed, owner, &seen, item)
+						if errors.Is(err, errEmailQuotaUsedUp) {
+							log.Printf("Daily email quota of %s used up, leaving the rest of %s for later\n", owner.ID.Hex(), hex.EncodeToString(feedDoc.ID[:]))
+							break
+						}
+						if err != nil {
+							log.Printf("Failed while checking %s (%s) for updates, failed with %s\n", key, hex.EncodeToString(feedDoc.ID[:]), err.Error())
+						}
+						if updated {
+							updatedItems++
+						}
+						continue
+					}
+					if !errors.Is(err, mongo.ErrNoDocuments) {
+						log.Printf("Tried to find %s (%s), failed with %s\n", key, hex.EncodeToString(feedDoc.ID[:]), err.Error())
 						continue
 					}
-					if exists != 0 {
+					if baseline || feedDoc.Delivery == structures.DeliveryNone {
+						err = a.markItemSeen(feedDoc.ID, key, item)
+						if err != nil {
+							log.Printf("Failed while inserting seen item for %s (%s), failed with %s\n", key, hex.EncodeToString(feedDoc.ID[:]), err.Error())
+						} else if !baseline {
+							newItems++
+						}
 						continue
 					}
-					err = a.sendEmailForItem(feedDoc.Feed, feedDoc.OwnerList[0], item)
+					// Items left unsent stay unseen, so they go out once the quota resets
+					ok, err := a.reserveEmail(context.TODO(), owner)
 					if err != nil {
-						log.Printf("Failed while sending email for %s (%s), failed with %s\n", item.GUID, hex.EncodeToString(feedDoc.ID[:]), err.Error())
+						log.Printf("Failed while counting the email for %s (%s), failed with %s\n", key, hex.EncodeToString(feedDoc.ID[:]), err.Error())
 						continue
 					}
-					_, err = a.seenItems.InsertOne(context.TODO(), structures.SeenItem{
-						ID:        primitive.NewObjectID(),
-						FeedID:    feedDoc.ID,
-						GUID:      item.GUID,
-						Timestamp: time.Now(),
-					})
+					if !ok {
+						log.Printf("Daily email quota of %s used up, leaving the rest of %s for later\n", owner.ID.Hex(), hex.EncodeToString(feedDoc.ID[:]))
+						break
+					}
+					err = a.sendEmailForItem(feedDoc.Feed, owner, item)
+					if err != nil {
+						log.Printf("Failed while sending email for %s (%s), failed with %s\n", key, hex.EncodeToString(feedDoc.ID[:]), err.Error())
+						continue
+					}
+					err = a.markItemSeen(feedDoc.ID, key, item)
 					if err != nil {
-						log.Printf("Failed while inserting seen item for %s (%s), failed with %s\n", item.GUID, hex.EncodeToString(feedDoc.ID[:]), err.Error())
+						log.Printf("Failed while inserting seen item for %s (%s), failed with %s\n", key, hex.EncodeToString(feedDoc.ID[:]), err.Error())
 						continue
 					}
+					newItems++
+				}
+
+				err = a.notifySavedSearches(feedDoc.Feed, owner, fresh, freshKeys)
+				if err != nil {
+					log.Printf("Failed while matching the saved searches of %s against %s, failed with %s\n", owner.ID.Hex(), hex.EncodeToString(feedDoc.ID[:]), err.Error())
 				}
 
+				set := bson.M{
+					"last_fetched":  now,
+					"last_error":    "",
+					"failing_since": time.Time{},
+				}
+				if feedDoc.Rebaseline {
+					set["rebaseline"] = false
+				}
+				if firstFetched.IsZero() {
+					firstFetched = now
+				}
+				if !firstFetched.Equal(feedDoc.FirstFetched) {
+					set["first_fetched"] = firstFetched
+				}
 				_, err = a.feeds.UpdateByID(context.TODO(), feedDoc.ID, bson.M{
-					"$set": bson.M{
-						"last_fetched": time.Now(),
-					},
+					"$set": set,
 				})
 
 				if err != nil {
@@ -187,6 +368,25 @@ func (a *app) onNotificationTick(tym time.Time) {
 
 				}
 
+				a.publishEvent(feedDoc.Owner, structures.Event{
+					Type:   structures.EventFeedFetched,
+					FeedID: feedDoc.ID,
+				})
+				if newItems != 0 {
+					a.publishEvent(feedDoc.Owner, structures.Event{
+						Type:   structures.EventNewItems,
+						FeedID: feedDoc.ID,
+						Count:  newItems,
+					})
+				}
+				if updatedItems != 0 {
+					a.publishEvent(feedDoc.Owner, structures.Event{
+						Type:   structures.EventUpdatedItems,
+						FeedID: feedDoc.ID,
+						Count:  updatedItems,
+					})
+				}
+
 				return nil
 			})
 		}
@@ -204,6 +404,126 @@ func (a *app) onNotificationTick(tym time.Time) {
 	}
 }
 
+// itemDate is when the item came out: published, else updated, else when it was first seen
+func itemDate(item *gofeed.Item, firstSeen time.Time) time.Time {
+	if item.PublishedParsed != nil {
+		return *item.PublishedParsed
+	}
+	if item.UpdatedParsed != nil {
+		return *item.UpdatedParsed
+	}
+	return firstSeen
+}
+
+func (a *app) markItemSeen(feedID primitive.ObjectID, key string, item *gofeed.Item) error {
+	content := newItemContent(item)
+	_, err := a.seenItems.InsertOne(context.TODO(), structures.SeenItem{
+		ID:          primitive.NewObjectID(),
+		FeedID:      feedID,
+		GUID:        key,
+		Timestamp:   time.Now(),
+		ContentHash: content.hash,
+		Updated:     updatedDate(item),
+		Title:       content.title,
+		Text:        content.text,
+	})
+	return err
+}
+
+func updatedDate(item *gofeed.Item) time.Time {
+	if item.UpdatedParsed != nil {
+		return *item.UpdatedParsed
+	}
+	return time.Time{}
+}
+
+// checkItemUpdate compares an item with how it was last seen, true if an update was e-mailed. Small changes aren't recorded
+// when updates are e-mailed, so that a series of them still adds up to an update eventually.
+func (a *app) checkItemUpdate(feed *structures.Feed, owner *structures.User, seen *structures.SeenItem, item *gofeed.Item) (bool, error) {
+	content := newItemContent(item)
+	if content.hash == seen.ContentHash {
+		return false, nil
+	}
+	// Items seen before updates were tracked only get their content recorded the first time around
+	if !feed.NotifyUpdates || feed.Delivery == structures.DeliveryNone || len(seen.ContentHash) == 0 {
+		return false, a.recordItemContent(seen.ID, item, content)
+	}
+
+	changes, changed, diffed := wordDiff(seen.Text, content.text)
+	if seen.Title == content.title && changed < minimumChangedWords {
+		return false, nil
+	}
+	ok, err := a.reserveEmail(context.TODO(), owner)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, errEmailQuotaUsedUp
+	}
+	err = a.sendUpdateEmailForItem(feed, owner, item, seen, content, changes, diffed)
+	if err != nil {
+		return false, err
+	}
+	return true, a.recordItemContent(seen.ID, item, content)
+}
+
+func (a *app) recordItemContent(seenID primitive.ObjectID, item *gofeed.Item, content itemContent) error {
+	_, err := a.seenItems.UpdateByID(context.TODO(), seenID, bson.M{
+		"$set": bson.M{
+			"content_hash": content.hash,
+			"updated":      updatedDate(item),
+			"title":        content.title,
+			"text":         content.text,
+		},
+	})
+	return err
+}
+
+// fetchFailure describes why a feed couldn't be fetched in terms that are safe to show its owner. The error itself may
+// name addresses and details of the server's network, it only goes to the log. Reasons are only told apart once the feed's
+// server answered, which the dialer only lets public addresses do: whether a host resolves, refuses or stays silent
+// would otherwise map out whatever the feed's URL points at.
+func fetchFailure(err error) string {
+	var httpErr gofeed.HTTPError
+	var certErr *tls.CertificateVerificationError
+	switch {
+	case errors.As(err, &httpErr):
+		return "The feed's server responded with " + strconv.Itoa(httpErr.StatusCode) + " " + http.StatusText(httpErr.StatusCode) + "."
+	case errors.Is(err, gofeed.ErrFeedTypeNotDetected):
+		return "The URL doesn't point to an RSS, Atom or JSON feed."
+	case errors.As(err, &certErr):
+		return "The feed's server has an invalid TLS certificate."
+	default:
+		return "The feed couldn't be fetched."
+	}
+}
+
+// markFeedFailing records why the feed failed, the owner only gets told when a working feed starts failing, not on every
+// tick after that
+func (a *app) markFeedFailing(feed *structures.Feed, fetchErr error) {
+	message := fetchFailure(fetchErr)
+	set := bson.M{
+		"last_error": message,
+	}
+	if len(feed.LastError) == 0 {
+		set["failing_since"] = time.Now()
+	}
+	_, err := a.feeds.UpdateByID(context.TODO(), feed.ID, bson.M{
+		"$set": set,
+	})
+	if err != nil {
+		log.Printf("Failed while updating last_error for %s, failed with %s\n", hex.EncodeToString(feed.ID[:]), err.Error())
+	}
+	if len(feed.LastError) == 0 {
+		a.publishEvent(feed.Owner, structures.Event{
+			Type:      structures.EventFeedFailing,
+			FeedID:    feed.ID,
+			Message:   message,
+			ErrorCode: structures.ErrorFeedUnreachable,
+		})
+	}
+}
+
 func (a *app) notificationLoop() {
 	go a.onNotificationTick(time.Now())
 	ticker := time.NewTicker(tickerTime)
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+)
+
+func TestFetchFailure(t *testing.T) {
+	generic := fetchFailure(nil)
+	// None of these had a public server answer, they must not be told apart
+	for _, err := range []error{
+		&url.Error{Op: "Get", URL: "http://internal", Err: &net.DNSError{Err: "no such host", Name: "internal", IsNotFound: true}},
+		&url.Error{Op: "Get", URL: "http://10.0.0.1", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errPrivateAddress}},
+		&url.Error{Op: "Get", URL: "http://example.com", Err: context.DeadlineExceeded},
+	} {
+		if got := fetchFailure(err); got != generic {
+			t.Errorf("%v: got %q, want %q", err, got, generic)
+		}
+	}
+
+	if got := fetchFailure(gofeed.HTTPError{StatusCode: 404, Status: "404 Not Found"}); got == generic {
+		t.Error("an HTTP status from the feed's server got the generic message")
+	}
+}
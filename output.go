@@ -0,0 +1,363 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The aggregated output turns the archive back into a feed, so that what's been gathered and filtered here can be read
+// anywhere else. It's polled by other readers, which mostly can't set headers, so the token may come in the query.
+
+const defaultOutputItems = 50
+
+type outputFormat struct {
+	contentType string
+	render      func(meta outputMeta, items []structures.Item) ([]byte, error)
+}
+
+type outputMeta struct {
+	Title string
+	// A URN, stable for the user or the feed
+	ID string
+	// The front-end
+	Link string
+}
+
+var outputFormats = map[string]outputFormat{
+	"atom": {"application/atom+xml; charset=utf-8", renderAtom},
+	"rss":  {"application/rss+xml; charset=utf-8", renderRSS},
+	"json": {"application/feed+json; charset=utf-8", renderJSONFeed},
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Published string      `xml:"published"`
+	Author    *atomAuthor `xml:"author,omitempty"`
+	Links     []atomLink  `xml:"link"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length uint64 `xml:"length,attr,omitempty"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title,omitempty"`
+	Link        string        `xml:"link,omitempty"`
+	Description string        `xml:"description"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Type   string `xml:"type,attr"`
+	Length uint64 `xml:"length,attr"`
+}
+
+// jsonFeed is version 1.1 of https://www.jsonfeed.org/version/1.1/
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string               `json:"id"`
+	URL           string               `json:"url,omitempty"`
+	Title         string               `json:"title,omitempty"`
+	ContentHTML   string               `json:"content_html"`
+	DatePublished string               `json:"date_published,omitempty"`
+	DateModified  string               `json:"date_modified,omitempty"`
+	Authors       []jsonFeedAuthor     `json:"authors,omitempty"`
+	Attachments   []jsonFeedAttachment `json:"attachments,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	SizeInBytes uint64 `json:"size_in_bytes,omitempty"`
+}
+
+func outputItemID(item *structures.Item) string {
+	return "urn:rss2email:item:" + item.ID.Hex()
+}
+
+// lastChange is the updated date, or the publish date for items that were never updated
+func lastChange(item *structures.Item) time.Time {
+	if item.Updated.After(item.Published) {
+		return item.Updated
+	}
+	return item.Published
+}
+
+func newestChange(items []structures.Item) time.Time {
+	var newest time.Time
+	for i := range items {
+		if t := lastChange(&items[i]); t.After(newest) {
+			newest = t
+		}
+	}
+	return newest
+}
+
+func renderAtom(meta outputMeta, items []structures.Item) ([]byte, error) {
+	feed := atomFeed{
+		ID:      meta.ID,
+		Title:   meta.Title,
+		Updated: newestChange(items).UTC().Format(time.RFC3339),
+		Link:    atomLink{Rel: "alternate", Href: meta.Link},
+		Author:  atomAuthor{Name: "rss2email"},
+		Entries: make([]atomEntry, 0, len(items)),
+	}
+	for i := range items {
+		item := &items[i]
+		entry := atomEntry{
+			ID:        outputItemID(item),
+			Title:     item.Title,
+			Updated:   lastChange(item).UTC().Format(time.RFC3339),
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Body: item.Content},
+		}
+		if len(item.Author) != 0 {
+			entry.Author = &atomAuthor{Name: item.Author}
+		}
+		if len(item.Link) != 0 {
+			entry.Links = append(entry.Links, atomLink{Rel: "alternate", Href: item.Link})
+		}
+		for _, e := range item.Enclosures {
+			entry.Links = append(entry.Links, atomLink{Rel: "enclosure", Href: e.URL, Type: e.Type, Length: e.Length})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return marshalXML(feed)
+}
+
+func renderRSS(meta outputMeta, items []structures.Item) ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         meta.Title,
+			Link:          meta.Link,
+			Description:   meta.Title,
+			LastBuildDate: newestChange(items).UTC().Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(items)),
+		},
+	}
+	for i := range items {
+		item := &items[i]
+		entry := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Content,
+			GUID:        rssGUID{Value: outputItemID(item)},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+		}
+		// RSS only has room for one
+		if len(item.Enclosures) != 0 {
+			e := item.Enclosures[0]
+			entry.Enclosure = &rssEnclosure{URL: e.URL, Type: e.Type, Length: e.Length}
+		}
+		feed.Channel.Items = append(feed.Channel.Items, entry)
+	}
+	return marshalXML(feed)
+}
+
+func renderJSONFeed(meta outputMeta, items []structures.Item) ([]byte, error) {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       meta.Title,
+		HomePageURL: meta.Link,
+		Items:       make([]jsonFeedItem, 0, len(items)),
+	}
+	for i := range items {
+		item := &items[i]
+		entry := jsonFeedItem{
+			ID:            outputItemID(item),
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   item.Content,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+		}
+		if !item.Updated.IsZero() {
+			entry.DateModified = item.Updated.UTC().Format(time.RFC3339)
+		}
+		if len(item.Author) != 0 {
+			entry.Authors = []jsonFeedAuthor{{Name: item.Author}}
+		}
+		for _, e := range item.Enclosures {
+			entry.Attachments = append(entry.Attachments, jsonFeedAttachment{URL: e.URL, MimeType: e.Type, SizeInBytes: e.Length})
+		}
+		feed.Items = append(feed.Items, entry)
+	}
+	return json.Marshal(feed)
+}
+
+func marshalXML(v interface{}) ([]byte, error) {
+	buf := bytes.NewBufferString(xml.Header)
+	err := xml.NewEncoder(buf).Encode(v)
+	return buf.Bytes(), err
+}
+
+// etagMatches follows If-None-Match's weak comparison
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// apiOutput serves the newest archived items of every feed, or of a single feed or saved search with feed_id. Tokens in
+// the query have to be API keys, session tokens carry the whole account and don't belong in URLs.
+func (a *app) apiOutput(w http.ResponseWriter, r *http.Request) {
+	format, ok := outputFormats[r.PathValue("format")]
+	if !ok {
+		a.writeAPIFailure(w, r, errNotFound)
+		return
+	}
+	query := r.URL.Query()
+
+	var userID primitive.ObjectID
+	var scopes []string
+	var err error
+	if token := query.Get("token"); len(token) != 0 {
+		if !strings.HasPrefix(token, apiKeyPrefix) {
+			a.writeAPIFailure(w, r, errUnauthorized)
+			return
+		}
+		userID, scopes, err = a.authenticateToken(r.Context(), token)
+	} else {
+		userID, scopes, err = a.authenticateBearer(r.Context(), r)
+	}
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+	if !hasScope(scopes, structures.ScopeOutput) {
+		a.writeAPIFailure(w, r, errInsufficientScope)
+		return
+	}
+	err = a.takeRateLimit(r.Context(), outputRateLimitName, userSubject(userID), ipSubject(remoteIP(r.RemoteAddr)))
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+
+	req := structures.ListItemsRequest{
+		UnreadOnly:  query.Get("unread_only") == "true",
+		StarredOnly: query.Get("starred_only") == "true",
+		PageSize:    defaultOutputItems,
+	}
+	if limit, err := strconv.ParseUint(query.Get("limit"), 10, 32); err == nil && limit != 0 {
+		req.PageSize = uint32(limit)
+	}
+	meta := outputMeta{
+		Title: "rss2email",
+		ID:    "urn:rss2email:output:" + userID.Hex(),
+		Link:  a.config.BaseURL,
+	}
+	if s := query.Get("feed_id"); len(s) != 0 {
+		req.FeedID, err = primitive.ObjectIDFromHex(s)
+		if err != nil {
+			a.writeAPIFailure(w, r, validationError("feed_id", "Errors.InvalidID", nil))
+			return
+		}
+		var feed structures.Feed
+		err = a.feeds.FindOne(r.Context(), bson.M{
+			"_id":      req.FeedID,
+			"owner_id": userID,
+		}).Decode(&feed)
+		if err != nil {
+			a.writeAPIFailure(w, r, err)
+			return
+		}
+		meta.Title = feed.Name
+		meta.ID += ":" + feed.ID.Hex()
+	}
+
+	resp, err := a.listItems(r.Context(), userID, &req)
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+	body, err := format.render(meta, resp.Items)
+	if err != nil {
+		a.writeAPIFailure(w, r, err)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", format.contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
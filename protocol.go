@@ -0,0 +1,34 @@
+package main
+
+import (
+	"git.maharshi.ninja/root/rss2email/structures"
+)
+
+// negotiateProtocol accepts the version the client speaks if the server still speaks it too, and picks the capabilities both
+// sides know. Clients that predate negotiation send no version and speak version 1. ok is false for versions the server
+// is too old or too new for.
+func negotiateProtocol(ir *structures.InitializationRequest) (version uint32, capabilities []string, ok bool) {
+	version = ir.ProtocolVersion
+	if version == 0 {
+		version = 1
+	}
+	if version < structures.MinProtocolVersion || version > structures.ProtocolVersion {
+		return 0, nil, false
+	}
+
+	capabilities = []string{}
+	for _, requested := range ir.Capabilities {
+		for _, supported := range structures.SupportedCapabilities {
+			if requested == supported {
+				capabilities = append(capabilities, requested)
+				break
+			}
+		}
+	}
+	return version, capabilities, true
+}
+
+func (c *connection) hasCapability(name string) bool {
+	_, ok := c.capabilities[name]
+	return ok
+}
@@ -0,0 +1,585 @@
+// Code generated by tools/protogen. DO NOT EDIT.
+
+import { decode, encode } from "@msgpack/msgpack";
+
+export const ErrorUnspecified = 0x0000;
+export const ErrorWhileDecoding = 0x0010;
+export const ErrorInvalidInputs = 0x0011;
+export const ErrorInvalidSignature = 0x0012;
+export const ErrorUnsupportedProtocolVersion = 0x0013;
+export const ErrorInsufficientScope = 0x0014;
+export const ErrorNotFound = 0x0015;
+export const ErrorUnauthorized = 0x0016;
+export const ErrorRateLimited = 0x0017;
+export const ErrorValidation = 0x0018;
+export const ErrorConflict = 0x0019;
+export const ErrorFeedUnreachable = 0x001A;
+export const ErrorQuotaExceeded = 0x001B;
+export const ErrorInternal = 0x0101;
+export const EventMessageID = 0xFFFFFFFF;
+export const EventFeedFetched = 0x01;
+export const EventNewItems = 0x02;
+export const EventFeedFailing = 0x03;
+export const EventEmailVerified = 0x04;
+export const EventUpdatedItems = 0x05;
+export const ProtocolVersion = 1;
+export const MinProtocolVersion = 1;
+export const CapabilityEvents = "events";
+export const RequestSupportedRequests = 0x0001;
+export const RequestBatch = 0x0002;
+export const RequestListFeeds = 0x0010;
+export const RequestAddFeed = 0x0011;
+export const RequestEditFeed = 0x0012;
+export const RequestRemoveFeed = 0x0013;
+export const RequestDeleteFeed = 0x0014;
+export const RequestEmailVerification = 0x0020;
+export const RequestEmailAgain = 0x0021;
+export const RequestDeleteAccount = 0x0030;
+export const RequestExportData = 0x0031;
+export const RequestIssueSessionToken = 0x0040;
+export const RequestCreateAPIKey = 0x0041;
+export const RequestListAPIKeys = 0x0042;
+export const RequestRevokeAPIKey = 0x0043;
+export const RequestListItems = 0x0050;
+export const RequestMarkItems = 0x0051;
+export const RequestStarItems = 0x0052;
+export const RequestSearchItems = 0x0053;
+export const SortByCreated = 0x00;
+export const SortByName = 0x01;
+export const SortByLastFetched = 0x02;
+export const SortByErrorState = 0x03;
+export const MaxItemIDs = 1000;
+export const MaxBatchSize = 100;
+export const DeliveryEmail = 0x00;
+export const DeliveryNone = 0x01;
+export const ImagesRemote = 0x00;
+export const ImagesInline = 0x01;
+export const ImagesProxy = 0x02;
+export const IdentityGUID = 0x00;
+export const IdentityLink = 0x01;
+export const IdentityHash = 0x02;
+export const ScopeFeedsRead = "feeds:read";
+export const ScopeFeedsWrite = "feeds:write";
+export const ScopeAccount = "account";
+export const ScopeOutput = "output";
+
+// ObjectIDs travel as their 12 raw bytes
+export type ObjectID = Uint8Array;
+
+export interface InitializationRequest {
+  address: Uint8Array;
+  locale: string;
+  api_key: string;
+  protocol_version: number;
+  capabilities: string[];
+}
+
+export interface InitializationResponse {
+  user_found: boolean;
+  challenge: Uint8Array;
+  protocol_version: number;
+  capabilities: string[];
+}
+
+export interface NewUserInitialization {
+  email: string;
+  signature: Uint8Array;
+}
+
+export interface OrdinaryInitialization {
+  signature: Uint8Array;
+}
+
+export interface Quota {
+  max_feeds: number;
+  minimum_frequency: number;
+  max_emails_per_day: number;
+}
+
+export interface User {
+  created_at: Date;
+  updated_at: Date;
+  id: ObjectID;
+  addr: Uint8Array;
+  email: string;
+  email_verified: boolean;
+  email_verification_token: Uint8Array;
+  email_verification_last: Date;
+  quota: Quota;
+  email_day: Date;
+  emails_today: number;
+}
+
+export interface Usage {
+  feeds: number;
+  emails_today: number;
+}
+
+export interface Welcome {
+  logged_in: boolean;
+  message: string;
+  user: User;
+  quota: Quota;
+  usage: Usage;
+  protocol_version: number;
+  capabilities: string[];
+}
+
+export type ErrorCode = number;
+
+export type ErrorMessage = [code: ErrorCode, message: string, field: string, correlationID: string, retryAfter: number];
+
+export type EventType = number;
+
+export interface Event {
+  type: EventType;
+  feed_id: ObjectID;
+  count: number;
+  message: string;
+  timestamp: Date;
+  error_code: ErrorCode;
+}
+
+export interface SupportedRequestsResponse {
+  request_ids: number[];
+}
+
+export interface BatchResult {
+  ok: boolean;
+  payload: Uint8Array;
+  error: ErrorMessage;
+}
+
+export interface BatchResponse {
+  committed: boolean;
+  results: BatchResult[];
+}
+
+export interface BatchItem {
+  request_id: number;
+  payload: Uint8Array;
+}
+
+export interface BatchRequest {
+  atomic: boolean;
+  requests: BatchItem[];
+}
+
+export interface Feed {
+  created_at: Date;
+  updated_at: Date;
+  id: ObjectID;
+  owner_id: ObjectID;
+  name: string;
+  feed_url: string;
+  frequency: number;
+  last_fetched: Date;
+  last_error: string;
+  failing_since: Date;
+  first_fetched: Date;
+  identity: number;
+  notify_updates: boolean;
+  full_text: boolean;
+  attach_enclosures: boolean;
+  images: number;
+  delivery: number;
+  query: string;
+  source_feeds: ObjectID[];
+}
+
+export interface ListFeedsResponse {
+  count: number;
+  feeds: Feed[];
+  continuation: string;
+}
+
+export interface ListFeedsRequest {
+  sort: number;
+  descending: boolean;
+  filter: string;
+  page_size: number;
+  continuation: string;
+}
+
+export interface GenericIDResponse {
+  ok: boolean;
+  id: ObjectID;
+}
+
+export interface UpdatedFeedResponse {
+  modified_count: number;
+}
+
+export interface DeleteFeedResponse {
+  deleted_count: number;
+}
+
+export interface DeleteFeedRequest {
+  id: ObjectID;
+}
+
+export interface Enclosure {
+  url: string;
+  type: string;
+  length: number;
+}
+
+export interface Item {
+  id: ObjectID;
+  feed_id: ObjectID;
+  owner_id: ObjectID;
+  key: string;
+  title: string;
+  link: string;
+  author: string;
+  content: string;
+  enclosures: Enclosure[];
+  published: Date;
+  updated: Date;
+  fetched_at: Date;
+  read: boolean;
+  starred: boolean;
+  searches: ObjectID[];
+}
+
+export interface ListItemsResponse {
+  count: number;
+  items: Item[];
+  continuation: string;
+}
+
+export interface ListItemsRequest {
+  feed_id: ObjectID;
+  unread_only: boolean;
+  starred_only: boolean;
+  page_size: number;
+  continuation: string;
+}
+
+export interface UpdatedItemsResponse {
+  modified_count: number;
+}
+
+export interface MarkItemsRequest {
+  ids: ObjectID[];
+  all: boolean;
+  feed_id: ObjectID;
+  read: boolean;
+}
+
+export interface StarItemsRequest {
+  ids: ObjectID[];
+  starred: boolean;
+}
+
+export interface SearchResult {
+  item: Item;
+  score: number;
+  snippet: string;
+}
+
+export interface SearchItemsResponse {
+  count: number;
+  results: SearchResult[];
+  continuation: string;
+}
+
+export interface SearchItemsRequest {
+  query: string;
+  feed_id: ObjectID;
+  since: Date;
+  until: Date;
+  page_size: number;
+  continuation: string;
+}
+
+export interface VerifyEmailRequest {
+  token: Uint8Array;
+}
+
+export interface DeleteAccountResponse {
+  deleted: boolean;
+  challenge: Uint8Array;
+}
+
+export interface DeleteAccountRequest {
+  signature: Uint8Array;
+}
+
+export interface ExportDataResponse {
+  file_name: string;
+  archive: Uint8Array;
+}
+
+export interface SessionTokenResponse {
+  token: string;
+  expires_at: Date;
+}
+
+export interface APIKey {
+  id: ObjectID;
+  user_id: ObjectID;
+  name: string;
+  prefix: string;
+  scopes: string[];
+  created_at: Date;
+  expires_at: Date;
+  last_used: Date;
+}
+
+export interface CreateAPIKeyResponse {
+  key: APIKey;
+  token: string;
+}
+
+export interface CreateAPIKeyRequest {
+  name: string;
+  scopes: string[];
+  expires_at: Date;
+}
+
+export interface ListAPIKeysResponse {
+  keys: APIKey[];
+}
+
+export interface RevokeAPIKeyResponse {
+  deleted_count: number;
+}
+
+export interface RevokeAPIKeyRequest {
+  id: ObjectID;
+}
+
+export class RequestError extends Error {
+  constructor(
+    readonly code: number,
+    message: string,
+    // The offending input for ErrorValidation
+    readonly field = "",
+    // Identifies the details in the server's log
+    readonly correlationID = "",
+    // In seconds, for ErrorRateLimited
+    readonly retryAfter = 0,
+  ) {
+    super(message);
+    this.name = "RequestError";
+  }
+
+  static from(msg: ErrorMessage): RequestError {
+    return new RequestError(...msg);
+  }
+}
+
+export type SignChallenge = (
+  challenge: Uint8Array,
+  userFound: boolean,
+) => Promise<NewUserInitialization | OrdinaryInitialization>;
+
+interface Pending {
+  resolve: (value: unknown) => void;
+  reject: (reason: unknown) => void;
+}
+
+// Client speaks the websocket protocol: requests go out as [ID u32 LE][RequestID u32 LE][msgpack body], responses
+// come back as [ID u32 LE][0xFF if OK][msgpack body]
+export class Client {
+  onEvent?: (event: Event) => void;
+  // Errors the server couldn't attribute to a message (ID 0), mostly bodies that failed to decode
+  onError?: (err: RequestError) => void;
+
+  private nextID = 1;
+  private pending = new Map<number, Pending>();
+  // During the handshake responses aren't matched by ID, they're handed out in order
+  private handshake: Pending[] | null = [];
+
+  constructor(private readonly ws: WebSocket) {
+    ws.binaryType = "arraybuffer";
+    ws.addEventListener("message", (ev) => this.receive(new Uint8Array(ev.data as ArrayBuffer)));
+    ws.addEventListener("close", () => {
+      const err = new RequestError(ErrorUnspecified, "connection closed");
+      this.handshake?.forEach((p) => p.reject(err));
+      this.pending.forEach((p) => p.reject(err));
+      this.pending.clear();
+    });
+  }
+
+  static connect(url: string): Promise<Client> {
+    return new Promise((resolve, reject) => {
+      const ws = new WebSocket(url);
+      ws.addEventListener("open", () => resolve(new Client(ws)), { once: true });
+      ws.addEventListener("error", reject, { once: true });
+    });
+  }
+
+  // login runs the handshake, sign is only called when logging in without an API key
+  async login(req: Partial<InitializationRequest>, sign?: SignChallenge): Promise<Welcome> {
+    this.send(this.nextID++, 0, { protocol_version: ProtocolVersion, ...req });
+    if (req.api_key) {
+      return this.finishHandshake(await this.nextHandshakeMessage());
+    }
+    const ir = (await this.nextHandshakeMessage()) as InitializationResponse;
+    if (!sign) {
+      throw new RequestError(ErrorInvalidInputs, "no way to sign the challenge");
+    }
+    this.send(this.nextID++, 0, await sign(ir.challenge, ir.user_found));
+    return this.finishHandshake(await this.nextHandshakeMessage());
+  }
+
+  close(): void {
+    this.ws.close();
+  }
+
+  // runBatch encodes the bodies for RequestBatch and decodes the results, failed items come back as RequestErrors
+  async runBatch(
+    items: [requestID: number, body: unknown][],
+    atomic = false,
+  ): Promise<{ committed: boolean; results: unknown[] }> {
+    const resp = await this.batch({
+      atomic,
+      requests: items.map(([request_id, body]) => ({ request_id, payload: encode(body) })),
+    });
+    return {
+      committed: resp.committed,
+      results: resp.results.map((r) => (r.ok ? decode(r.payload) : RequestError.from(r.error))),
+    };
+  }
+
+  private finishHandshake(msg: unknown): Welcome {
+    this.handshake = null;
+    return msg as Welcome;
+  }
+
+  private nextHandshakeMessage(): Promise<unknown> {
+    return new Promise((resolve, reject) => this.handshake?.push({ resolve, reject }));
+  }
+
+  private request<T>(requestID: number, body: unknown): Promise<T> {
+    const id = this.nextID++;
+    if (this.nextID >= EventMessageID) {
+      this.nextID = 1;
+    }
+    return new Promise<T>((resolve, reject) => {
+      this.pending.set(id, { resolve: resolve as (value: unknown) => void, reject });
+      this.send(id, requestID, body);
+    });
+  }
+
+  private send(id: number, requestID: number, body: unknown): void {
+    const encoded = encode(body);
+    const buf = new Uint8Array(8 + encoded.length);
+    const view = new DataView(buf.buffer);
+    view.setUint32(0, id, true);
+    view.setUint32(4, requestID, true);
+    buf.set(encoded, 8);
+    this.ws.send(buf);
+  }
+
+  private receive(buf: Uint8Array): void {
+    const view = new DataView(buf.buffer, buf.byteOffset, buf.byteLength);
+    const id = view.getUint32(0, true);
+    const ok = buf[4] === 0xff;
+    const body = decode(buf.subarray(5));
+
+    if (id === EventMessageID) {
+      this.onEvent?.(body as Event);
+      return;
+    }
+
+    let err: RequestError | undefined;
+    if (!ok) {
+      err = RequestError.from(body as ErrorMessage);
+    }
+
+    const handshake = this.handshake?.shift();
+    if (handshake) {
+      err ? handshake.reject(err) : handshake.resolve(body);
+      return;
+    }
+
+    const p = this.pending.get(id);
+    if (!p) {
+      if (err) {
+        this.onError?.(err);
+      }
+      return;
+    }
+    this.pending.delete(id);
+    err ? p.reject(err) : p.resolve(body);
+  }
+
+  supportedRequests(): Promise<SupportedRequestsResponse> {
+    return this.request(RequestSupportedRequests, null);
+  }
+
+  batch(req: BatchRequest): Promise<BatchResponse> {
+    return this.request(RequestBatch, req);
+  }
+
+  listFeeds(req: ListFeedsRequest): Promise<ListFeedsResponse> {
+    return this.request(RequestListFeeds, req);
+  }
+
+  addFeed(req: Feed): Promise<GenericIDResponse> {
+    return this.request(RequestAddFeed, req);
+  }
+
+  editFeed(req: Feed): Promise<UpdatedFeedResponse> {
+    return this.request(RequestEditFeed, req);
+  }
+
+  removeFeed(req: DeleteFeedRequest): Promise<DeleteFeedResponse> {
+    return this.request(RequestRemoveFeed, req);
+  }
+
+  deleteFeed(req: DeleteFeedRequest): Promise<DeleteFeedResponse> {
+    return this.request(RequestDeleteFeed, req);
+  }
+
+  listItems(req: ListItemsRequest): Promise<ListItemsResponse> {
+    return this.request(RequestListItems, req);
+  }
+
+  markItems(req: MarkItemsRequest): Promise<UpdatedItemsResponse> {
+    return this.request(RequestMarkItems, req);
+  }
+
+  starItems(req: StarItemsRequest): Promise<UpdatedItemsResponse> {
+    return this.request(RequestStarItems, req);
+  }
+
+  searchItems(req: SearchItemsRequest): Promise<SearchItemsResponse> {
+    return this.request(RequestSearchItems, req);
+  }
+
+  emailVerification(req: VerifyEmailRequest): Promise<GenericIDResponse> {
+    return this.request(RequestEmailVerification, req);
+  }
+
+  emailAgain(): Promise<boolean> {
+    return this.request(RequestEmailAgain, null);
+  }
+
+  deleteAccount(req: DeleteAccountRequest): Promise<DeleteAccountResponse> {
+    return this.request(RequestDeleteAccount, req);
+  }
+
+  exportData(): Promise<ExportDataResponse> {
+    return this.request(RequestExportData, null);
+  }
+
+  issueSessionToken(): Promise<SessionTokenResponse> {
+    return this.request(RequestIssueSessionToken, null);
+  }
+
+  createAPIKey(req: CreateAPIKeyRequest): Promise<CreateAPIKeyResponse> {
+    return this.request(RequestCreateAPIKey, req);
+  }
+
+  listAPIKeys(): Promise<ListAPIKeysResponse> {
+    return this.request(RequestListAPIKeys, null);
+  }
+
+  revokeAPIKey(req: RevokeAPIKeyRequest): Promise<RevokeAPIKeyResponse> {
+    return this.request(RequestRevokeAPIKey, req);
+  }
+}
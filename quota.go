@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultMaxFeeds        = 500
+	defaultMaxEmailsPerDay = 200
+)
+
+// effectiveQuota fills the fields the user doesn't have their own value for with the configured defaults
+func (a *app) effectiveQuota(u *structures.User) structures.Quota {
+	q := u.Quota
+	if q.MaxFeeds == 0 {
+		q.MaxFeeds = a.config.Quota.MaxFeeds
+	}
+	if q.MinimumFrequency == 0 {
+		q.MinimumFrequency = time.Duration(a.config.Quota.MinimumFrequency) * time.Second
+	}
+	if q.MaxEmailsPerDay == 0 {
+		q.MaxEmailsPerDay = a.config.Quota.MaxEmailsPerDay
+	}
+	return q
+}
+
+func (a *app) quotaFor(ctx context.Context, userID primitive.ObjectID) (structures.Quota, error) {
+	var u structures.User
+	err := a.users.FindOne(ctx, bson.M{"_id": userID}).Decode(&u)
+	if err != nil {
+		return structures.Quota{}, err
+	}
+	return a.effectiveQuota(&u), nil
+}
+
+func emailDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+func (a *app) usage(ctx context.Context, u *structures.User) (structures.Usage, error) {
+	feeds, err := a.feeds.CountDocuments(ctx, bson.M{
+		"owner_id": u.ID,
+	})
+	if err != nil {
+		return structures.Usage{}, err
+	}
+	usage := structures.Usage{
+		Feeds: uint64(feeds),
+	}
+	if u.EmailDay.Equal(emailDay(time.Now())) {
+		usage.EmailsToday = u.EmailsToday
+	}
+	return usage, nil
+}
+
+// reserveEmail counts an e-mail against the user's daily quota, false once the quota is used up. It's counted before
+// sending, so failed attempts count as well.
+func (a *app) reserveEmail(ctx context.Context, u *structures.User) (bool, error) {
+	today := emailDay(time.Now())
+	result, err := a.users.UpdateOne(ctx, bson.M{
+		"_id": u.ID,
+		"$or": bson.A{
+			bson.M{"email_day": bson.M{"$ne": today}},
+			bson.M{"emails_today": bson.M{"$lt": a.effectiveQuota(u).MaxEmailsPerDay}},
+		},
+	}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "emails_today", Value: bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{"$email_day", today}},
+				bson.M{"$add": bson.A{"$emails_today", 1}},
+				1,
+			}}},
+			{Key: "email_day", Value: today},
+		}}},
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount != 0, nil
+}
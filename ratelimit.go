@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// rateLimit is a token bucket: Burst tokens at most, refilled at Rate tokens per second. A negative Rate disables the limit.
+type rateLimit struct {
+	Rate  float64
+	Burst float64
+}
+
+func (l rateLimit) unlimited() bool {
+	return l.Rate < 0
+}
+
+// valid rejects a zero Rate, which would be an endless wait rather than no limit
+func (l rateLimit) valid() bool {
+	return l.unlimited() || (l.Rate > 0 && l.Burst >= 1)
+}
+
+const (
+	// loginRateLimitName is the pseudo-request initialization is limited under, it's only keyed by IP as there's no user yet
+	loginRateLimitName = "Login"
+	// outputRateLimitName limits polling the aggregated feed output
+	outputRateLimitName = "Output"
+	// imagesRateLimitName limits the image proxy per IP, mail clients that proxy images themselves share a few
+	imagesRateLimitName = "Images"
+)
+
+var (
+	defaultRateLimit = rateLimit{Rate: 5, Burst: 30}
+	// Used for whatever the configuration doesn't mention, keyed by the names in structures.Requests
+	builtinRateLimits = map[string]rateLimit{
+		"AddFeed":           {Rate: 0.2, Burst: 20},
+		"EmailAgain":        {Rate: 1.0 / 60, Burst: 3},
+		"CreateAPIKey":      {Rate: 1.0 / 60, Burst: 5},
+		"SearchItems":       {Rate: 1, Burst: 10},
+		loginRateLimitName:  {Rate: 0.2, Burst: 10},
+		outputRateLimitName: {Rate: 0.1, Burst: 10},
+		imagesRateLimitName: {Rate: 10, Burst: 200},
+	}
+)
+
+// RateLimiter takes a token from the bucket under key, a non-zero wait means there was none left
+type RateLimiter interface {
+	Take(ctx context.Context, key string, limit rateLimit) (wait time.Duration, err error)
+}
+
+// memoryLimiter only limits what reaches this very process
+type memoryLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens  float64
+	last    time.Time
+	refills time.Time
+}
+
+func newMemoryLimiter() *memoryLimiter {
+	return &memoryLimiter{
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+func (l *memoryLimiter) Take(_ context.Context, key string, limit rateLimit) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: limit.Burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(limit.Burst, b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		b.refills = now.Add(time.Duration((limit.Burst - b.tokens) / limit.Rate * float64(time.Second)))
+		return 0, nil
+	}
+	return time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)), nil
+}
+
+// sweep forgets full buckets every now and then, they're no different from ones that don't exist
+func (l *memoryLimiter) sweep(interval time.Duration) {
+	for range time.Tick(interval) {
+		now := time.Now()
+		l.mu.Lock()
+		for key, b := range l.buckets {
+			if b.refills.Before(now) {
+				delete(l.buckets, key)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+// mongoLimiter keeps the buckets in a collection so that every instance shares them, the refill is computed by the server
+// with its own clock so that instances with skewed clocks don't matter
+type mongoLimiter struct {
+	coll *mongo.Collection
+}
+
+type storedBucket struct {
+	Tokens  float64 `bson:"tokens"`
+	Allowed bool    `bson:"allowed"`
+}
+
+func (l *mongoLimiter) Take(ctx context.Context, key string, limit rateLimit) (time.Duration, error) {
+	elapsed := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{"$$NOW", bson.M{"$ifNull": bson.A{"$updated_at", "$$NOW"}}}},
+		1000,
+	}}
+	refilled := bson.M{"$min": bson.A{
+		limit.Burst,
+		bson.M{"$add": bson.A{
+			bson.M{"$ifNull": bson.A{"$tokens", limit.Burst}},
+			bson.M{"$multiply": bson.A{limit.Rate, elapsed}},
+		}},
+	}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{{Key: "tokens", Value: refilled}, {Key: "updated_at", Value: "$$NOW"}}}},
+		{{Key: "$set", Value: bson.D{{Key: "allowed", Value: bson.M{"$gte": bson.A{"$tokens", 1}}}}}},
+		{{Key: "$set", Value: bson.D{{Key: "tokens", Value: bson.M{"$cond": bson.A{
+			"$allowed",
+			bson.M{"$subtract": bson.A{"$tokens", 1}},
+			"$tokens",
+		}}}}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var b storedBucket
+	err := l.coll.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&b)
+	// Two instances upserting the same new bucket at once, the loser just has to go again
+	if mongo.IsDuplicateKeyError(err) {
+		err = l.coll.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&b)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if b.Allowed {
+		return 0, nil
+	}
+	return time.Duration((1 - b.Tokens) / limit.Rate * float64(time.Second)), nil
+}
+
+func rateLimitedError(wait time.Duration) *requestError {
+	seconds := uint32(math.Ceil(wait.Seconds()))
+	return &requestError{
+		Code:      structures.ErrorRateLimited,
+		MessageID: "Errors.RateLimited",
+		TemplateData: map[string]uint32{
+			"Seconds": seconds,
+		},
+		RetryAfter: seconds,
+	}
+}
+
+// rateLimitFor resolves the configured limit of a request, or of the Login pseudo-request
+func (a *app) rateLimitFor(name string) rateLimit {
+	if l, ok := a.config.RateLimits.Requests[name]; ok {
+		return l
+	}
+	if l, ok := builtinRateLimits[name]; ok {
+		return l
+	}
+	return a.config.RateLimits.Default
+}
+
+// takeRateLimit takes a token from the bucket of every subject (user and/or IP) for the named request. The limiter failing
+// doesn't keep anyone out, it's only logged.
+func (a *app) takeRateLimit(ctx context.Context, name string, subjects ...string) error {
+	limit := a.rateLimitFor(name)
+	if limit.unlimited() {
+		return nil
+	}
+
+	var longest time.Duration
+	for _, subject := range subjects {
+		wait, err := a.rateLimiter.Take(ctx, subject+":"+name, limit)
+		if err != nil {
+			log.Printf("Rate limiter failed, letting the request through: %s\n", err.Error())
+			continue
+		}
+		longest = max(longest, wait)
+	}
+	if longest != 0 {
+		return rateLimitedError(longest)
+	}
+	return nil
+}
+
+func userSubject(userID primitive.ObjectID) string {
+	return "user:" + userID.Hex()
+}
+
+func ipSubject(ip string) string {
+	return "ip:" + ip
+}
+
+// remoteIP is the peer's address, as there's nothing in front of the daemon that could be trusted to forward the client's
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// requestName is what limits are configured under
+func requestName(requestID uint32) string {
+	for _, r := range structures.Requests {
+		if r.ID == requestID {
+			return r.Name
+		}
+	}
+	return "0x" + strconv.FormatUint(uint64(requestID), 16)
+}
+
+func (c *connection) takeRateLimit(ctx context.Context, requestID uint32) error {
+	return c.a.takeRateLimit(ctx, requestName(requestID), userSubject(c.userID), ipSubject(c.ip))
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/ugorji/go/codec"
+)
+
+type requestRoute struct {
+	// Only matters for connections logged in with an API key
+	scope string
+	// call decodes the request and runs the handler, the context is a transaction's inside atomic batches
+	call func(c *connection, ctx context.Context, buf []byte) (interface{}, error)
+	// raw is for the rare handler that has to deal with the framing itself, those can't be batched
+	raw func(c *connection, mi *MessageInfo, buf []byte)
+}
+
+// route wraps a typed handler: the request is decoded into Req, and the result is encoded as the response or the error
+func route[Req any, Resp any](scope string, h func(c *connection, ctx context.Context, req *Req) (Resp, error)) requestRoute {
+	return requestRoute{
+		scope: scope,
+		call: func(c *connection, ctx context.Context, buf []byte) (interface{}, error) {
+			req := new(Req)
+			err := codec.NewDecoderBytes(buf, c.a.codecHandle).Decode(req)
+			if err != nil {
+				return nil, decodingError(err)
+			}
+			return h(c, ctx, req)
+		},
+	}
+}
+
+// routeNoInput is for requests without a body
+func routeNoInput[Resp any](scope string, h func(c *connection, ctx context.Context) (Resp, error)) requestRoute {
+	return requestRoute{
+		scope: scope,
+		call: func(c *connection, ctx context.Context, _ []byte) (interface{}, error) {
+			return h(c, ctx)
+		},
+	}
+}
+
+func rawRoute(scope string, h func(c *connection, mi *MessageInfo, buf []byte)) requestRoute {
+	return requestRoute{
+		scope: scope,
+		raw:   h,
+	}
+}
+
+func (r requestRoute) serve(c *connection, mi *MessageInfo, buf []byte) {
+	if r.raw != nil {
+		r.raw(c, mi, buf)
+		return
+	}
+	resp, err := r.call(c, c.ctx, buf)
+	c.respond(mi, resp, err)
+}
+
+func (c *connection) respond(mi *MessageInfo, resp interface{}, err error) {
+	if err != nil {
+		c.writeError(mi, err)
+		return
+	}
+	c.writeMessage(true, mi, resp)
+}
+
+var requestRoutes map[uint32]requestRoute
+
+// Filled in init, the introspection handler refers back to the table
+func init() {
+	requestRoutes = map[uint32]requestRoute{
+		structures.RequestSupportedRequests: routeNoInput("", (*connection).handleSupportedRequests),
+		// Every sub-request is checked against its own scope
+		structures.RequestBatch: route("", (*connection).handleBatch),
+
+		structures.RequestListFeeds:  route(structures.ScopeFeedsRead, (*connection).handleListFeeds),
+		structures.RequestAddFeed:    route(structures.ScopeFeedsWrite, (*connection).handleAddFeed),
+		structures.RequestEditFeed:   route(structures.ScopeFeedsWrite, (*connection).handleEditFeed),
+		structures.RequestRemoveFeed: route(structures.ScopeFeedsWrite, (*connection).handleDeleteFeed),
+		structures.RequestDeleteFeed: route(structures.ScopeFeedsWrite, (*connection).handleDeleteFeed),
+
+		structures.RequestListItems:   route(structures.ScopeFeedsRead, (*connection).handleListItems),
+		structures.RequestMarkItems:   route(structures.ScopeFeedsWrite, (*connection).handleMarkItems),
+		structures.RequestStarItems:   route(structures.ScopeFeedsWrite, (*connection).handleStarItems),
+		structures.RequestSearchItems: route(structures.ScopeFeedsRead, (*connection).handleSearchItems),
+
+		structures.RequestEmailVerification: route(structures.ScopeAccount, (*connection).handleEmailVerification),
+		structures.RequestEmailAgain:        routeNoInput(structures.ScopeAccount, (*connection).handleEmailRequest),
+
+		structures.RequestDeleteAccount: rawRoute(structures.ScopeAccount, (*connection).handleDeleteAccount),
+		structures.RequestExportData:    routeNoInput(structures.ScopeAccount, (*connection).handleExportData),
+
+		structures.RequestIssueSessionToken: routeNoInput(structures.ScopeAccount, (*connection).handleIssueSessionToken),
+		structures.RequestCreateAPIKey:      route(structures.ScopeAccount, (*connection).handleCreateAPIKey),
+		structures.RequestListAPIKeys:       routeNoInput(structures.ScopeAccount, (*connection).handleListAPIKeys),
+		structures.RequestRevokeAPIKey:      route(structures.ScopeAccount, (*connection).handleRevokeAPIKey),
+	}
+
+	// The generated schema and client are built from structures.Requests, so it has to cover exactly what's routed
+	described := make(map[uint32]struct{}, len(structures.Requests))
+	for _, d := range structures.Requests {
+		if _, ok := requestRoutes[d.ID]; !ok {
+			panic(fmt.Sprintf("request %s (0x%04x) is described but not routed", d.Name, d.ID))
+		}
+		described[d.ID] = struct{}{}
+	}
+	for id := range requestRoutes {
+		if _, ok := described[id]; !ok {
+			panic(fmt.Sprintf("request 0x%04x is routed but missing from structures.Requests", id))
+		}
+	}
+}
+
+func (c *connection) handleSupportedRequests(_ context.Context) (*structures.SupportedRequestsResponse, error) {
+	ids := make([]uint32, 0, len(requestRoutes))
+	for id := range requestRoutes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return &structures.SupportedRequestsResponse{
+		RequestIDs: ids,
+	}, nil
+}
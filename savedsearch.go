@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"log"
+	"strings"
+	"unicode"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"github.com/mmcdole/gofeed"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Saved searches are feeds with a query and no URL, so that they're listed, edited and deleted like any other feed
+
+var errInvalidSourceFeeds = validationError("source_feeds", "Errors.InvalidSourceFeeds", nil)
+
+// savedQuery follows the syntax of SearchItemsRequest.Query: an item matches if it has any of the words, every phrase and
+// none of the excluded words
+type savedQuery struct {
+	words    []string
+	phrases  []string
+	excluded []string
+}
+
+// wordsOf splits text the way queries are matched, marks are kept as they're part of words in scripts like Bengali
+func wordsOf(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+}
+
+func parseSavedQuery(query string) savedQuery {
+	var q savedQuery
+	// Odd parts were between quotes
+	for i, part := range strings.Split(query, `"`) {
+		if i%2 == 1 {
+			if phrase := strings.Join(wordsOf(part), " "); len(phrase) != 0 {
+				q.phrases = append(q.phrases, phrase)
+			}
+			continue
+		}
+		for _, field := range strings.Fields(part) {
+			if strings.HasPrefix(field, "-") {
+				q.excluded = append(q.excluded, wordsOf(field)...)
+			} else {
+				q.words = append(q.words, wordsOf(field)...)
+			}
+		}
+	}
+	return q
+}
+
+func (q savedQuery) empty() bool {
+	return len(q.words) == 0 && len(q.phrases) == 0
+}
+
+func (q savedQuery) matches(title, text string) bool {
+	words := wordsOf(title + " " + text)
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		set[w] = struct{}{}
+	}
+	for _, w := range q.excluded {
+		if _, ok := set[w]; ok {
+			return false
+		}
+	}
+	joined := " " + strings.Join(words, " ") + " "
+	for _, phrase := range q.phrases {
+		if !strings.Contains(joined, " "+phrase+" ") {
+			return false
+		}
+	}
+	if len(q.words) == 0 {
+		return true
+	}
+	for _, w := range q.words {
+		if _, ok := set[w]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSavedSearch only lets a saved search look at real feeds of its owner
+func (a *app) validateSavedSearch(ctx context.Context, userID primitive.ObjectID, req *structures.Feed) error {
+	if len(req.Query) > maxQueryLength || parseSavedQuery(req.Query).empty() {
+		return errInvalidQuery
+	}
+	req.URL = ""
+	if len(req.SourceFeeds) == 0 {
+		return nil
+	}
+
+	seen := make(map[primitive.ObjectID]struct{}, len(req.SourceFeeds))
+	unique := make([]primitive.ObjectID, 0, len(req.SourceFeeds))
+	for _, id := range req.SourceFeeds {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			unique = append(unique, id)
+		}
+	}
+	count, err := a.feeds.CountDocuments(ctx, bson.M{
+		"_id":      bson.M{"$in": unique},
+		"owner_id": userID,
+		"query":    bson.M{"$in": bson.A{nil, ""}},
+	})
+	if err != nil {
+		return err
+	}
+	if count != int64(len(unique)) {
+		return errInvalidSourceFeeds
+	}
+	req.SourceFeeds = unique
+	return nil
+}
+
+func (a *app) savedSearchesFor(ctx context.Context, feed *structures.Feed) ([]structures.Feed, error) {
+	var searches []structures.Feed
+	cursor, err := a.feeds.Find(ctx, bson.M{
+		"owner_id": feed.Owner,
+		"query":    bson.M{"$gt": ""},
+		"$or": bson.A{
+			bson.M{"source_feeds": feed.ID},
+			bson.M{"source_feeds": nil},
+			bson.M{"source_feeds": bson.M{"$size": 0}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &searches)
+	return searches, err
+}
+
+// notifySavedSearches runs a feed's new items through its owner's saved searches. An item matching several of them is
+// e-mailed once, naming every one that wants e-mails. Unlike a feed's own items, matches are not retried once the e-mail
+// quota is used up, they're still in the archive.
+func (a *app) notifySavedSearches(feed *structures.Feed, owner *structures.User, items []*gofeed.Item, keys []string) error {
+	if len(items) == 0 {
+		return nil
+	}
+	searches, err := a.savedSearchesFor(context.TODO(), feed)
+	if err != nil || len(searches) == 0 {
+		return err
+	}
+	queries := make([]savedQuery, len(searches))
+	for i := range searches {
+		queries[i] = parseSavedQuery(searches[i].Query)
+	}
+
+	counts := make(map[primitive.ObjectID]uint64)
+	quotaUsedUp := false
+	for i, item := range items {
+		content := newItemContent(item)
+		var ids []primitive.ObjectID
+		var mailing []*structures.Feed
+		for j := range searches {
+			if !queries[j].matches(content.title, content.text) {
+				continue
+			}
+			ids = append(ids, searches[j].ID)
+			counts[searches[j].ID]++
+			if searches[j].Delivery == structures.DeliveryEmail {
+				mailing = append(mailing, &searches[j])
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		_, err = a.items.UpdateOne(context.TODO(), bson.M{
+			"feed_id": feed.ID,
+			"key":     keys[i],
+		}, bson.M{
+			"$addToSet": bson.M{"searches": bson.M{"$each": ids}},
+		})
+		if err != nil {
+			log.Printf("Failed while tagging %s (%s) with its saved searches, failed with %s\n", keys[i], hex.EncodeToString(feed.ID[:]), err.Error())
+		}
+		if len(mailing) == 0 || quotaUsedUp {
+			continue
+		}
+
+		ok, err := a.reserveEmail(context.TODO(), owner)
+		if err != nil {
+			log.Printf("Failed while counting the email for %s (%s), failed with %s\n", keys[i], hex.EncodeToString(feed.ID[:]), err.Error())
+			continue
+		}
+		if !ok {
+			log.Printf("Daily email quota of %s used up, not mailing saved search matches from %s\n", owner.ID.Hex(), hex.EncodeToString(feed.ID[:]))
+			quotaUsedUp = true
+			continue
+		}
+		err = a.sendSearchEmailForItem(mailing, feed, owner, item)
+		if err != nil {
+			log.Printf("Failed while sending saved search email for %s (%s), failed with %s\n", keys[i], hex.EncodeToString(feed.ID[:]), err.Error())
+		}
+	}
+
+	for id, count := range counts {
+		a.publishEvent(feed.Owner, structures.Event{
+			Type:   structures.EventNewItems,
+			FeedID: id,
+			Count:  count,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"html"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+	maxQueryLength        = 500
+	// Roughly how much text a snippet carries on each side of the match, in runes
+	snippetContext = 80
+)
+
+var errInvalidQuery = validationError("query", "Errors.InvalidQuery", map[string]int{
+	"Max": maxQueryLength,
+})
+
+// searchContinuation is an offset, scores don't make for a stable cursor the way dates and IDs do
+type searchContinuation struct {
+	Query  string             `bson:"q"`
+	FeedID primitive.ObjectID `bson:"f"`
+	Since  time.Time          `bson:"s"`
+	Until  time.Time          `bson:"u"`
+	Offset int64              `bson:"o"`
+}
+
+type scoredItem struct {
+	structures.Item `bson:",inline"`
+	Score           float64 `bson:"score"`
+}
+
+// queryTerms are the words of a query worth highlighting, negated ones aren't in the results anyway
+func queryTerms(query string) []string {
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		if strings.HasPrefix(word, "-") {
+			continue
+		}
+		word = strings.Trim(word, `"`)
+		if len(word) != 0 {
+			terms = append(terms, strings.ToLower(word))
+		}
+	}
+	return terms
+}
+
+// matchTerm is how many bytes of s, from its start, match the lowercase term regardless of case, or 0. It compares rune
+// by rune as lowercasing can change byte lengths, offsets into a lowered copy don't hold for the original.
+func matchTerm(s, term string) int {
+	n := 0
+	for _, want := range term {
+		if n >= len(s) {
+			return 0
+		}
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if r != want && unicode.ToLower(r) != want && !strings.EqualFold(string(r), string(want)) {
+			return 0
+		}
+		n += size
+	}
+	return n
+}
+
+// longestMatch is the longest term matching at the start of s, in bytes of s
+func longestMatch(s string, terms []string) int {
+	matched := 0
+	for _, term := range terms {
+		matched = max(matched, matchTerm(s, term))
+	}
+	return matched
+}
+
+// snippet cuts the text around the first term found and marks every term in it, the title stands in for texts without one
+func snippet(text, title string, terms []string) string {
+	first := -1
+	for i := 0; i < len(text); {
+		if longestMatch(text[i:], terms) != 0 {
+			first = i
+			break
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	if first == -1 {
+		if len(text) == 0 {
+			text = title
+		}
+		first = 0
+	}
+
+	start := first
+	for n := 0; n < snippetContext && start > 0; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:start])
+		start -= size
+	}
+	end := first
+	for n := 0; n < 2*snippetContext && end < len(text); n++ {
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+
+	bldr := new(strings.Builder)
+	if start != 0 {
+		bldr.WriteString("…")
+	}
+	for i := start; i < end; {
+		if matched := longestMatch(text[i:end], terms); matched != 0 {
+			bldr.WriteString("<mark>")
+			bldr.WriteString(html.EscapeString(text[i : i+matched]))
+			bldr.WriteString("</mark>")
+			i += matched
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		bldr.WriteString(html.EscapeString(text[i : i+size]))
+		i += size
+	}
+	if end != len(text) {
+		bldr.WriteString("…")
+	}
+	return bldr.String()
+}
+
+func (a *app) searchItems(ctx context.Context, userID primitive.ObjectID, req *structures.SearchItemsRequest) (*structures.SearchItemsResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if len(query) == 0 || len(query) > maxQueryLength {
+		return nil, errInvalidQuery
+	}
+	pageSize := int64(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	var offset int64
+	if len(req.Continuation) != 0 {
+		raw, err := base64.RawURLEncoding.DecodeString(req.Continuation)
+		if err != nil {
+			return nil, errInvalidContinuation
+		}
+		var cont searchContinuation
+		err = bson.Unmarshal(raw, &cont)
+		if err != nil || cont.Query != req.Query || cont.FeedID != req.FeedID || !cont.Since.Equal(req.Since) || !cont.Until.Equal(req.Until) || cont.Offset < 0 {
+			return nil, errInvalidContinuation
+		}
+		offset = cont.Offset
+	}
+
+	filter := bson.M{
+		"owner_id": userID,
+		"$text":    bson.M{"$search": query},
+	}
+	if !req.FeedID.IsZero() {
+		filter["feed_id"] = req.FeedID
+	}
+	published := bson.M{}
+	if !req.Since.IsZero() {
+		published["$gte"] = req.Since
+	}
+	if !req.Until.IsZero() {
+		published["$lt"] = req.Until
+	}
+	if len(published) != 0 {
+		filter["published"] = published
+	}
+
+	total, err := a.items.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	var items []scoredItem
+	// One extra to know whether there's another page
+	cursor, err := a.items.Find(ctx, filter, options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "published", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(pageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	err = cursor.All(ctx, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &structures.SearchItemsResponse{
+		Count:   uint64(total),
+		Results: make([]structures.SearchResult, 0, len(items)),
+	}
+	if int64(len(items)) > pageSize {
+		items = items[:pageSize]
+		raw, err := bson.Marshal(searchContinuation{
+			Query:  req.Query,
+			FeedID: req.FeedID,
+			Since:  req.Since,
+			Until:  req.Until,
+			Offset: offset + pageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Continuation = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	terms := queryTerms(query)
+	for _, item := range items {
+		resp.Results = append(resp.Results, structures.SearchResult{
+			Item:    item.Item,
+			Score:   item.Score,
+			Snippet: snippet(item.Text, item.Title, terms),
+		})
+	}
+	return resp, nil
+}
+
+func (c *connection) handleSearchItems(ctx context.Context, req *structures.SearchItemsRequest) (*structures.SearchItemsResponse, error) {
+	return c.a.searchItems(ctx, c.userID, req)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSnippetMarksTerms(t *testing.T) {
+	got := snippet("The Quick brown fox <jumps>", "", queryTerms("quick -brown fox"))
+	want := "The <mark>Quick</mark> brown <mark>fox</mark> &lt;jumps&gt;"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSnippetFallsBackToTitle(t *testing.T) {
+	if got := snippet("", "A title", queryTerms("title")); got != "A <mark>title</mark>" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestSnippetRunesGrowingWhenLowered(t *testing.T) {
+	// U+023A is 2 bytes and lowercases to the 3 byte U+2C65, offsets into a lowered copy run past the text
+	text := strings.Repeat("Ⱥ", 100) + " needle"
+	got := snippet(text, "", queryTerms("needle"))
+	if !strings.HasSuffix(got, " <mark>needle</mark>") {
+		t.Errorf("needle isn't marked in %q", got)
+	}
+	got = snippet(text, "", queryTerms("ⱥⱥ"))
+	if !strings.HasPrefix(got, "<mark>ȺȺ</mark>") {
+		t.Errorf("the uppercase runes aren't matched in %q", got)
+	}
+}
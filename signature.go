@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/storyicon/sigverify"
+	"golang.org/x/xerrors"
+)
+
+// SignatureVerifier checks that signature was produced by (or on behalf of) addr over an EIP-191 personal message
+type SignatureVerifier interface {
+	Verify(ctx context.Context, addr [20]byte, message []byte, signature []byte) (bool, error)
+}
+
+// chainedVerifier tries every verifier in order and accepts the first positive result
+type chainedVerifier []SignatureVerifier
+
+func (cv chainedVerifier) Verify(ctx context.Context, addr [20]byte, message []byte, signature []byte) (bool, error) {
+	var lastErr error
+	for _, v := range cv {
+		ok, err := v.Verify(ctx, addr, message, signature)
+		if ok {
+			return true, nil
+		}
+		if err != nil {
+			lastErr = err
+		}
+	}
+	return false, lastErr
+}
+
+// ecdsaVerifier handles plain EOA (secp256k1) signatures, it doesn't need any network access so it should always go first
+type ecdsaVerifier struct{}
+
+func (ecdsaVerifier) Verify(_ context.Context, addr [20]byte, message []byte, signature []byte) (bool, error) {
+	if len(signature) != 65 {
+		return false, xerrors.New("not an ECDSA signature")
+	}
+	return sigverify.VerifyEllipticCurveSignature(addr, message, signature)
+}
+
+// eip6492MagicSuffix is appended to signatures from counterfactual (not yet deployed) contract wallets
+var eip6492MagicSuffix = ethcommon.FromHex("0x6492649264926492649264926492649264926492649264926492649264926492")
+
+var eip6492Arguments = func() abi.Arguments {
+	addressType, _ := abi.NewType("address", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	return abi.Arguments{{Type: addressType}, {Type: bytesType}, {Type: bytesType}}
+}()
+
+// erc1271Magic is both isValidSignature's selector and what it returns for valid signatures
+var erc1271Magic = []byte{0x16, 0x26, 0xba, 0x7e}
+
+var erc1271Arguments = func() abi.Arguments {
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	return abi.Arguments{{Type: bytes32Type}, {Type: bytesType}}
+}()
+
+func isValidSignatureCalldata(hash ethcommon.Hash, signature []byte) ([]byte, error) {
+	packed, err := erc1271Arguments.Pack(hash, signature)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, erc1271Magic...), packed...), nil
+}
+
+// eip6492Validator is the init code of a contract that's never deployed, only run through eth_call: it calls the factory to
+// deploy the wallet, then asks the wallet through isValidSignature and returns the call's success flag as a word followed
+// by the first word of what it returned. Nothing of it lasts past the call.
+func eip6492Validator(factory ethcommon.Address, factoryCalldata []byte, wallet ethcommon.Address, calldata []byte) []byte {
+	emit := func(factoryOffset, calldataOffset uint32) []byte {
+		code := new(bytes.Buffer)
+		push4 := func(n uint32) {
+			code.Write([]byte{0x63, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)})
+		}
+		// CODECOPY(0, factoryOffset, len(factoryCalldata))
+		push4(uint32(len(factoryCalldata)))
+		push4(factoryOffset)
+		code.Write([]byte{0x60, 0x00, 0x39})
+		// CALL(gas, factory, 0, 0, len(factoryCalldata), 0, 0), a failure shows in the wallet having no code
+		code.Write([]byte{0x60, 0x00, 0x60, 0x00})
+		push4(uint32(len(factoryCalldata)))
+		code.Write([]byte{0x60, 0x00, 0x60, 0x00, 0x73})
+		code.Write(factory[:])
+		code.Write([]byte{0x5a, 0xf1, 0x50})
+		// CODECOPY(0, calldataOffset, len(calldata))
+		push4(uint32(len(calldata)))
+		push4(calldataOffset)
+		code.Write([]byte{0x60, 0x00, 0x39})
+		// STATICCALL(gas, wallet, 0, len(calldata), 0, 0)
+		code.Write([]byte{0x60, 0x00, 0x60, 0x00})
+		push4(uint32(len(calldata)))
+		code.Write([]byte{0x60, 0x00, 0x73})
+		code.Write(wallet[:])
+		code.Write([]byte{0x5a, 0xfa})
+		// MSTORE(0, success), MSTORE(0x20, 0), RETURNDATACOPY(0x20, 0, RETURNDATASIZE), RETURN(0, 0x40)
+		code.Write([]byte{0x60, 0x00, 0x52})
+		code.Write([]byte{0x60, 0x00, 0x60, 0x20, 0x52})
+		code.Write([]byte{0x3d, 0x60, 0x00, 0x60, 0x20, 0x3e})
+		code.Write([]byte{0x60, 0x40, 0x60, 0x00, 0xf3})
+		return code.Bytes()
+	}
+	// Offsets are always pushed as 4 bytes, so the length of the code before the data doesn't depend on them
+	prefix := uint32(len(emit(0, 0)))
+	code := emit(prefix, prefix+uint32(len(factoryCalldata)))
+	code = append(code, factoryCalldata...)
+	return append(code, calldata...)
+}
+
+// eip1271Verifier asks the wallet contract itself through isValidSignature, using the configured JSON-RPC endpoint.
+// Counterfactual wallets (EIP-6492) are deployed by their factory and asked within a single eth_call against the pending
+// state, so they can sign in before they're ever deployed.
+type eip1271Verifier struct {
+	client *ethclient.Client
+}
+
+func newEIP1271Verifier(rpcURL string) (*eip1271Verifier, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	return &eip1271Verifier{client: client}, nil
+}
+
+func (v *eip1271Verifier) Verify(ctx context.Context, addr [20]byte, message []byte, signature []byte) (bool, error) {
+	address := ethcommon.Address(addr)
+
+	var factory ethcommon.Address
+	var factoryCalldata []byte
+	wrapped := bytes.HasSuffix(signature, eip6492MagicSuffix)
+	if wrapped {
+		unpacked, err := eip6492Arguments.Unpack(signature[:len(signature)-len(eip6492MagicSuffix)])
+		if err != nil {
+			return false, err
+		}
+		factory = unpacked[0].(ethcommon.Address)
+		factoryCalldata = unpacked[1].([]byte)
+		signature = unpacked[2].([]byte)
+	}
+	calldata, err := isValidSignatureCalldata(ethcommon.BytesToHash(accounts.TextHash(message)), signature)
+	if err != nil {
+		return false, err
+	}
+
+	code, err := v.client.PendingCodeAt(ctx, address)
+	if err != nil {
+		return false, err
+	}
+	if len(code) == 0 {
+		if !wrapped {
+			return false, xerrors.New("no contract deployed at the address")
+		}
+		result, err := v.client.PendingCallContract(ctx, ethereum.CallMsg{
+			Data: eip6492Validator(factory, factoryCalldata, address, calldata),
+		})
+		if err != nil {
+			return false, err
+		}
+		return len(result) == 64 && result[31] == 1 && bytes.Equal(result[32:36], erc1271Magic), nil
+	}
+
+	// Already deployed, the wrapper's inner signature is an ordinary ERC-1271 one
+	result, err := v.client.PendingCallContract(ctx, ethereum.CallMsg{
+		To:   &address,
+		Data: calldata,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(result) >= 4 && bytes.Equal(result[:4], erc1271Magic), nil
+}
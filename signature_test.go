@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// The stand-in wallet accepts a signature whose first word is the hash itself:
+//
+//	CALLDATALOAD(4) == CALLDATALOAD(0x64) ? 0x1626ba7e : 0
+var testWalletRuntime = append(append(
+	ethcommon.FromHex("0x600435606435147f"),
+	ethcommon.RightPadBytes(erc1271Magic, 32)...),
+	ethcommon.FromHex("0x0260005260206000f3")...)
+
+// deployCode wraps runtime code in init code that returns it
+func deployCode(runtime []byte) []byte {
+	return append([]byte{0x60, byte(len(runtime)), 0x80, 0x60, 0x0b, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3}, runtime...)
+}
+
+// testFactoryRuntime CREATEs the wallet whatever it's called with, the init code follows the 21 bytes of the factory
+var testFactoryRuntime = func() []byte {
+	walletInit := deployCode(testWalletRuntime)
+	code := []byte{0x60, byte(len(walletInit)), 0x80, 0x60, 0x15, 0x60, 0x00, 0x39, 0x60, 0x00, 0x60, 0x00, 0xf0, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	return append(code, walletInit...)
+}()
+
+var (
+	testFactory  = ethcommon.HexToAddress("0x000000000000000000000000000000000000fac7")
+	testDeployed = ethcommon.HexToAddress("0x000000000000000000000000000000000000d00d")
+	// The factory's first CREATE, accounts with code start at nonce 1
+	testCounterfactual = crypto.CreateAddress(testFactory, 1)
+)
+
+// testRPCNode is a stand-in for an Ethereum node, eth_getCode and eth_call against an in-memory state that calls never
+// change, whatever block they ask for
+type testRPCNode struct {
+	state *state.StateDB
+}
+
+type testCallArgs struct {
+	To    *ethcommon.Address `json:"to"`
+	Input hexutil.Bytes      `json:"input"`
+}
+
+func (n *testRPCNode) GetCode(addr ethcommon.Address, _ rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	return n.state.GetCode(addr), nil
+}
+
+func (n *testRPCNode) Call(args testCallArgs, _ rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	cfg := &runtime.Config{State: n.state.Copy(), GasLimit: 30_000_000}
+	if args.To == nil {
+		ret, _, _, err := runtime.Create(args.Input, cfg)
+		return ret, err
+	}
+	ret, _, err := runtime.Call(*args.To, args.Input, cfg)
+	return ret, err
+}
+
+// newTestRPCNode serves the stand-in over HTTP, with the factory and an already deployed wallet in its state
+func newTestRPCNode(t *testing.T) string {
+	t.Helper()
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for addr, code := range map[ethcommon.Address][]byte{
+		testFactory:  testFactoryRuntime,
+		testDeployed: testWalletRuntime,
+	} {
+		statedb.SetCode(addr, code)
+		statedb.SetNonce(addr, 1)
+	}
+
+	srv := rpc.NewServer()
+	err = srv.RegisterName("eth", &testRPCNode{state: statedb})
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		httpSrv.Close()
+		srv.Stop()
+	})
+	return httpSrv.URL
+}
+
+func wrap6492(t *testing.T, factory ethcommon.Address, calldata []byte, signature []byte) []byte {
+	t.Helper()
+	packed, err := eip6492Arguments.Pack(factory, calldata, signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(packed, eip6492MagicSuffix...)
+}
+
+func TestEIP1271Verifier(t *testing.T) {
+	v, err := newEIP1271Verifier(newTestRPCNode(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := []byte("Log in to rss2email")
+	valid := accounts.TextHash(message)
+	invalid := make([]byte, 32)
+
+	cases := []struct {
+		name      string
+		addr      ethcommon.Address
+		signature []byte
+		ok        bool
+		err       bool
+	}{
+		{"deployed", testDeployed, valid, true, false},
+		{"deployed, wrong signature", testDeployed, invalid, false, false},
+		{"deployed, wrapped", testDeployed, wrap6492(t, testFactory, []byte{0x01}, valid), true, false},
+		{"counterfactual", testCounterfactual, wrap6492(t, testFactory, []byte{0x01}, valid), true, false},
+		{"counterfactual, wrong signature", testCounterfactual, wrap6492(t, testFactory, []byte{0x01}, invalid), false, false},
+		{"counterfactual, wrong factory", testCounterfactual, wrap6492(t, testDeployed, []byte{0x01}, valid), false, false},
+		{"counterfactual, unwrapped", testCounterfactual, valid, false, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ok, err := v.Verify(context.Background(), c.addr, message, c.signature)
+			if (err != nil) != c.err {
+				t.Fatalf("got error %v", err)
+			}
+			if ok != c.ok {
+				t.Errorf("got %t, want %t", ok, c.ok)
+			}
+		})
+	}
+
+	// Verifying doesn't deploy anything
+	code, err := v.client.CodeAt(context.Background(), testCounterfactual, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != 0 {
+		t.Error("the counterfactual wallet got deployed")
+	}
+}
@@ -3,10 +3,19 @@ package structures
 type ErrorCode uint64
 
 const (
-	ErrorUnspecified      = 0x0000
-	ErrorWhileDecoding    = 0x0010
-	ErrorInvalidInputs    = 0x0011
-	ErrorInvalidSignature = 0x0012
+	ErrorUnspecified                = 0x0000
+	ErrorWhileDecoding              = 0x0010
+	ErrorInvalidInputs              = 0x0011
+	ErrorInvalidSignature           = 0x0012
+	ErrorUnsupportedProtocolVersion = 0x0013
+	ErrorInsufficientScope          = 0x0014
+	ErrorNotFound                   = 0x0015
+	ErrorUnauthorized               = 0x0016
+	ErrorRateLimited                = 0x0017
+	ErrorValidation                 = 0x0018 // ErrorMessage.Field names the offending input
+	ErrorConflict                   = 0x0019
+	ErrorFeedUnreachable            = 0x001A // Fetching a feed failed, the message says why as far as that's safe to tell
+	ErrorQuotaExceeded              = 0x001B
 
-	ErrorInternal = 0x0101
+	ErrorInternal = 0x0101 // ErrorMessage.CorrelationID identifies the details in the server's log
 )
@@ -0,0 +1,30 @@
+package structures
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventMessageID is never used by clients for requests, messages carrying it are pushed by the server on its own
+const EventMessageID = 0xFFFFFFFF
+
+type EventType uint8
+
+const (
+	EventFeedFetched   EventType = 0x01
+	EventNewItems      EventType = 0x02
+	EventFeedFailing   EventType = 0x03
+	EventEmailVerified EventType = 0x04
+	EventUpdatedItems  EventType = 0x05
+)
+
+type Event struct {
+	Type      EventType          `codec:"type" bson:"type"`
+	FeedID    primitive.ObjectID `codec:"feed_id" bson:"feed_id"`
+	Count     uint64             `codec:"count" bson:"count"`
+	Message   string             `codec:"message" bson:"message"`
+	Timestamp time.Time          `codec:"timestamp" bson:"timestamp"`
+	// ErrorFeedUnreachable for EventFeedFailing
+	ErrorCode ErrorCode `codec:"error_code" bson:"error_code"`
+}
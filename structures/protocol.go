@@ -0,0 +1,17 @@
+package structures
+
+// ProtocolVersion is bumped whenever the wire format or the shape of a request/response changes incompatibly
+const (
+	ProtocolVersion    = 1
+	MinProtocolVersion = 1
+)
+
+// Capabilities are optional features a client opts into during initialization, the server only enables the ones both sides know
+const (
+	CapabilityEvents = "events"
+)
+
+// SupportedCapabilities lists every capability this server understands
+var SupportedCapabilities = []string{
+	CapabilityEvents,
+}
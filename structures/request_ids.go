@@ -1,6 +1,8 @@
 package structures
 
 const (
+	RequestSupportedRequests = 0x0001
+	RequestBatch             = 0x0002
 	RequestListFeeds         = 0x0010
 	RequestAddFeed           = 0x0011
 	RequestEditFeed          = 0x0012
@@ -8,4 +10,14 @@ const (
 	RequestDeleteFeed        = 0x0014
 	RequestEmailVerification = 0x0020
 	RequestEmailAgain        = 0x0021
+	RequestDeleteAccount     = 0x0030
+	RequestExportData        = 0x0031
+	RequestIssueSessionToken = 0x0040
+	RequestCreateAPIKey      = 0x0041
+	RequestListAPIKeys       = 0x0042
+	RequestRevokeAPIKey      = 0x0043
+	RequestListItems         = 0x0050
+	RequestMarkItems         = 0x0051
+	RequestStarItems         = 0x0052
+	RequestSearchItems       = 0x0053
 )
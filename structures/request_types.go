@@ -1,14 +1,107 @@
 package structures
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Sort orders for ListFeedsRequest, ties are broken by creation order
+const (
+	SortByCreated     uint8 = 0x00
+	SortByName        uint8 = 0x01
+	SortByLastFetched uint8 = 0x02
+	// Failing feeds first, most recently broken first
+	SortByErrorState uint8 = 0x03
+)
 
 type ListFeedsRequest struct {
-	Sort uint8 `codec:"sort"`
+	Sort       uint8 `codec:"sort"`
+	Descending bool  `codec:"descending"`
+	// Case-insensitive substring of the name, URL or saved search query
+	Filter string `codec:"filter"`
+	// Zero picks the default page size
+	PageSize uint32 `codec:"page_size"`
+	// Opaque, taken from the previous ListFeedsResponse
+	Continuation string `codec:"continuation"`
 }
 
 type ListFeedsResponse struct {
+	// Total amount of feeds matching the filter, not just the ones on this page
 	Count uint64 `codec:"count"`
 	Feeds []Feed `codec:"feeds"`
+	// Empty on the last page
+	Continuation string `codec:"continuation"`
+}
+
+type ListItemsRequest struct {
+	// Zero lists the items of every feed
+	FeedID      primitive.ObjectID `codec:"feed_id"`
+	UnreadOnly  bool               `codec:"unread_only"`
+	StarredOnly bool               `codec:"starred_only"`
+	// Zero picks the default page size
+	PageSize uint32 `codec:"page_size"`
+	// Opaque, taken from the previous ListItemsResponse
+	Continuation string `codec:"continuation"`
+}
+
+// ListItemsResponse has the newest items first
+type ListItemsResponse struct {
+	// Total amount of items matching the request, not just the ones on this page
+	Count uint64 `codec:"count"`
+	Items []Item `codec:"items"`
+	// Empty on the last page
+	Continuation string `codec:"continuation"`
+}
+
+// MaxItemIDs is the most items a single MarkItemsRequest or StarItemsRequest may name
+const MaxItemIDs = 1000
+
+type MarkItemsRequest struct {
+	IDs []primitive.ObjectID `codec:"ids"`
+	// All ignores IDs and marks every item, of FeedID only unless it's zero
+	All    bool               `codec:"all"`
+	FeedID primitive.ObjectID `codec:"feed_id"`
+	Read   bool               `codec:"read"`
+}
+
+type StarItemsRequest struct {
+	IDs     []primitive.ObjectID `codec:"ids"`
+	Starred bool                 `codec:"starred"`
+}
+
+type UpdatedItemsResponse struct {
+	ModifiedCount uint64 `codec:"modified_count"`
+}
+
+type SearchItemsRequest struct {
+	// Words to look for, "quoted phrases" have to appear as is and -words must not appear
+	Query string `codec:"query"`
+	// Zero searches every feed
+	FeedID primitive.ObjectID `codec:"feed_id"`
+	// Bounds on the publish date, zero for no bound
+	Since time.Time `codec:"since"`
+	Until time.Time `codec:"until"`
+	// Zero picks the default page size
+	PageSize uint32 `codec:"page_size"`
+	// Opaque, taken from the previous SearchItemsResponse
+	Continuation string `codec:"continuation"`
+}
+
+type SearchResult struct {
+	Item  Item    `codec:"item"`
+	Score float64 `codec:"score"`
+	// Plain text around the first match, HTML escaped with the matching words in <mark> tags
+	Snippet string `codec:"snippet"`
+}
+
+// SearchItemsResponse has the best matches first
+type SearchItemsResponse struct {
+	// Total amount of matches, not just the ones on this page
+	Count   uint64         `codec:"count"`
+	Results []SearchResult `codec:"results"`
+	// Empty on the last page
+	Continuation string `codec:"continuation"`
 }
 
 type DeleteFeedRequest struct {
@@ -31,3 +124,78 @@ type UpdatedFeedResponse struct {
 type VerifyEmailRequest struct {
 	Token [32]byte `codec:"token"`
 }
+
+type DeleteAccountRequest struct {
+	Signature []byte `codec:"signature"`
+}
+
+type DeleteAccountResponse struct {
+	Deleted   bool   `codec:"deleted"`
+	Challenge []byte `codec:"challenge"`
+}
+
+type ExportDataResponse struct {
+	FileName string `codec:"file_name"`
+	Archive  []byte `codec:"archive"`
+}
+
+type SessionTokenResponse struct {
+	Token     string    `codec:"token"`
+	ExpiresAt time.Time `codec:"expires_at"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name   string   `codec:"name"`
+	Scopes []string `codec:"scopes"`
+	// Zero means the key never expires
+	ExpiresAt time.Time `codec:"expires_at"`
+}
+
+type CreateAPIKeyResponse struct {
+	Key   APIKey `codec:"key"`
+	Token string `codec:"token"`
+}
+
+type ListAPIKeysResponse struct {
+	Keys []APIKey `codec:"keys"`
+}
+
+type RevokeAPIKeyRequest struct {
+	ID primitive.ObjectID `codec:"id"`
+}
+
+type RevokeAPIKeyResponse struct {
+	DeletedCount int64 `codec:"deleted_count"`
+}
+
+type SupportedRequestsResponse struct {
+	RequestIDs []uint32 `codec:"request_ids"`
+}
+
+// MaxBatchSize is the most sub-requests a single RequestBatch may carry
+const MaxBatchSize = 100
+
+type BatchItem struct {
+	RequestID uint32 `codec:"request_id"`
+	// The msgpack encoded body, exactly what would follow the MessageInfo header in a standalone request
+	Payload []byte `codec:"payload"`
+}
+
+type BatchRequest struct {
+	// Atomic runs the batch inside a transaction and stops at the first failure, nothing is persisted unless every item succeeds
+	Atomic   bool        `codec:"atomic"`
+	Requests []BatchItem `codec:"requests"`
+}
+
+type BatchResult struct {
+	OK bool `codec:"ok"`
+	// The msgpack encoded response when OK
+	Payload []byte       `codec:"payload"`
+	Error   ErrorMessage `codec:"error"`
+}
+
+type BatchResponse struct {
+	// Always true for non-atomic batches, whose items are persisted one by one
+	Committed bool          `codec:"committed"`
+	Results   []BatchResult `codec:"results"`
+}
@@ -0,0 +1,48 @@
+package structures
+
+// RequestDescription ties a request ID to the types travelling in each direction, Request is nil for requests without a body
+type RequestDescription struct {
+	ID       uint32
+	Name     string
+	Request  interface{}
+	Response interface{}
+}
+
+// Requests is the catalogue the schema and client generator works from, the server refuses to start if its routes don't match it
+var Requests = []RequestDescription{
+	{RequestSupportedRequests, "SupportedRequests", nil, SupportedRequestsResponse{}},
+	{RequestBatch, "Batch", BatchRequest{}, BatchResponse{}},
+
+	{RequestListFeeds, "ListFeeds", ListFeedsRequest{}, ListFeedsResponse{}},
+	{RequestAddFeed, "AddFeed", Feed{}, GenericIDResponse{}},
+	{RequestEditFeed, "EditFeed", Feed{}, UpdatedFeedResponse{}},
+	{RequestRemoveFeed, "RemoveFeed", DeleteFeedRequest{}, DeleteFeedResponse{}},
+	{RequestDeleteFeed, "DeleteFeed", DeleteFeedRequest{}, DeleteFeedResponse{}},
+
+	{RequestListItems, "ListItems", ListItemsRequest{}, ListItemsResponse{}},
+	{RequestMarkItems, "MarkItems", MarkItemsRequest{}, UpdatedItemsResponse{}},
+	{RequestStarItems, "StarItems", StarItemsRequest{}, UpdatedItemsResponse{}},
+	{RequestSearchItems, "SearchItems", SearchItemsRequest{}, SearchItemsResponse{}},
+
+	{RequestEmailVerification, "EmailVerification", VerifyEmailRequest{}, GenericIDResponse{}},
+	{RequestEmailAgain, "EmailAgain", nil, true},
+
+	{RequestDeleteAccount, "DeleteAccount", DeleteAccountRequest{}, DeleteAccountResponse{}},
+	{RequestExportData, "ExportData", nil, ExportDataResponse{}},
+
+	{RequestIssueSessionToken, "IssueSessionToken", nil, SessionTokenResponse{}},
+	{RequestCreateAPIKey, "CreateAPIKey", CreateAPIKeyRequest{}, CreateAPIKeyResponse{}},
+	{RequestListAPIKeys, "ListAPIKeys", nil, ListAPIKeysResponse{}},
+	{RequestRevokeAPIKey, "RevokeAPIKey", RevokeAPIKeyRequest{}, RevokeAPIKeyResponse{}},
+}
+
+// Handshake describes the initialization messages, they're exchanged before any request and don't use request IDs
+var Handshake = []interface{}{
+	InitializationRequest{},
+	InitializationResponse{},
+	NewUserInitialization{},
+	OrdinaryInitialization{},
+	Welcome{},
+	ErrorMessage{},
+	Event{},
+}
@@ -0,0 +1,123 @@
+package structures
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	objectIDType = reflect.TypeOf(primitive.ObjectID{})
+)
+
+// JSONSchema describes t the way the codec package serializes it, following the codec struct tags. Named structs are put into
+// defs and referenced through refPrefix (e.g. "#/components/schemas/" for OpenAPI), so shared types only show up once.
+func JSONSchema(t reflect.Type, defs map[string]interface{}, refPrefix string) map[string]interface{} {
+	return (&schemaBuilder{defs: defs, refPrefix: refPrefix}).schema(t)
+}
+
+// MsgpackSchema is JSONSchema for the websocket protocol, the only difference is ObjectIDs being sent as 12 raw bytes rather than hex
+func MsgpackSchema(t reflect.Type, defs map[string]interface{}, refPrefix string) map[string]interface{} {
+	return (&schemaBuilder{defs: defs, refPrefix: refPrefix, binaryIDs: true}).schema(t)
+}
+
+type schemaBuilder struct {
+	defs      map[string]interface{}
+	refPrefix string
+	binaryIDs bool
+}
+
+func (b *schemaBuilder) schema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case durationType:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case objectIDType:
+		if b.binaryIDs {
+			return map[string]interface{}{"type": "string", "format": "byte", "minLength": 12, "maxLength": 12}
+		}
+		return map[string]interface{}{"type": "string", "pattern": "^[0-9a-f]{24}$"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		// Byte slices and arrays are written as binary (base64 in JSON)
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+		if _, ok := b.defs[t.Name()]; !ok {
+			// Placeholder first, so that self-referencing types terminate
+			b.defs[t.Name()] = nil
+			b.defs[t.Name()] = b.structSchema(t)
+		}
+		return map[string]interface{}{"$ref": b.refPrefix + t.Name()}
+	}
+	return map[string]interface{}{}
+}
+
+func (b *schemaBuilder) structSchema(t reflect.Type) map[string]interface{} {
+	toArray := false
+	if f, ok := t.FieldByName("_struct"); ok {
+		toArray = strings.Contains(f.Tag.Get("codec"), "toarray")
+	}
+
+	properties := map[string]interface{}{}
+	var items []interface{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("codec"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s := b.schema(f.Type)
+		if toArray {
+			s["title"] = name
+			items = append(items, s)
+			continue
+		}
+		properties[name] = s
+	}
+
+	if toArray {
+		return map[string]interface{}{
+			"type":        "array",
+			"prefixItems": items,
+			"minItems":    len(items),
+		}
+	}
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+}
@@ -94,12 +94,12 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(8)
+			z.EncWriteArrayStart(11)
 			z.EncWriteArrayElem()
 			if z.EncBasicHandle().TimeBuiltin() {
 				r.EncodeTime(x.CreatedAt)
-			} else if yyxt11 := z.Extension(x.CreatedAt); yyxt11 != nil {
-				z.EncExtension(x.CreatedAt, yyxt11)
+			} else if yyxt14 := z.Extension(x.CreatedAt); yyxt14 != nil {
+				z.EncExtension(x.CreatedAt, yyxt14)
 			} else if z.EncBinary() {
 				z.EncBinaryMarshal(x.CreatedAt)
 			} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -110,8 +110,8 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 			z.EncWriteArrayElem()
 			if z.EncBasicHandle().TimeBuiltin() {
 				r.EncodeTime(x.UpdatedAt)
-			} else if yyxt12 := z.Extension(x.UpdatedAt); yyxt12 != nil {
-				z.EncExtension(x.UpdatedAt, yyxt12)
+			} else if yyxt15 := z.Extension(x.UpdatedAt); yyxt15 != nil {
+				z.EncExtension(x.UpdatedAt, yyxt15)
 			} else if z.EncBinary() {
 				z.EncBinaryMarshal(x.UpdatedAt)
 			} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -120,29 +120,29 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				z.EncFallback(x.UpdatedAt)
 			}
 			z.EncWriteArrayElem()
-			yy13 := &x.ID
-			if yyxt14 := z.Extension(yy13); yyxt14 != nil {
-				z.EncExtension(yy13, yyxt14)
+			yy16 := &x.ID
+			if yyxt17 := z.Extension(yy16); yyxt17 != nil {
+				z.EncExtension(yy16, yyxt17)
 			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(*yy13)
+				z.EncJSONMarshal(*yy16)
 			} else {
-				z.F.EncSliceUint8V(([]uint8)(yy13[:]), e)
+				z.F.EncSliceUint8V(([]uint8)(yy16[:]), e)
 			}
 			z.EncWriteArrayElem()
-			yy15 := &x.Address
-			h.encArray20uint8((*[20]uint8)(yy15), e)
+			yy18 := &x.Address
+			h.encArray20uint8((*[20]uint8)(yy18), e)
 			z.EncWriteArrayElem()
 			r.EncodeString(string(x.Email))
 			z.EncWriteArrayElem()
 			r.EncodeBool(bool(x.EmailVerified))
 			z.EncWriteArrayElem()
-			yy19 := &x.EmailVerificationToken
-			h.encArray32uint8((*[32]uint8)(yy19), e)
+			yy22 := &x.EmailVerificationToken
+			h.encArray32uint8((*[32]uint8)(yy22), e)
 			z.EncWriteArrayElem()
 			if z.EncBasicHandle().TimeBuiltin() {
 				r.EncodeTime(x.EmailVerificationLast)
-			} else if yyxt21 := z.Extension(x.EmailVerificationLast); yyxt21 != nil {
-				z.EncExtension(x.EmailVerificationLast, yyxt21)
+			} else if yyxt24 := z.Extension(x.EmailVerificationLast); yyxt24 != nil {
+				z.EncExtension(x.EmailVerificationLast, yyxt24)
 			} else if z.EncBinary() {
 				z.EncBinaryMarshal(x.EmailVerificationLast)
 			} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -150,22 +150,43 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 			} else {
 				z.EncFallback(x.EmailVerificationLast)
 			}
+			z.EncWriteArrayElem()
+			yy25 := &x.Quota
+			if yyxt26 := z.Extension(yy25); yyxt26 != nil {
+				z.EncExtension(yy25, yyxt26)
+			} else {
+				yy25.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.EmailDay)
+			} else if yyxt27 := z.Extension(x.EmailDay); yyxt27 != nil {
+				z.EncExtension(x.EmailDay, yyxt27)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.EmailDay)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.EmailDay)
+			} else {
+				z.EncFallback(x.EmailDay)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.EmailsToday))
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(8)
+			z.EncWriteMapStart(11)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
 				r.EncodeString(`addr`)
 				z.EncWriteMapElemValue()
-				yy22 := &x.Address
-				h.encArray20uint8((*[20]uint8)(yy22), e)
+				yy29 := &x.Address
+				h.encArray20uint8((*[20]uint8)(yy29), e)
 				z.EncWriteMapElemKey()
 				r.EncodeString(`created_at`)
 				z.EncWriteMapElemValue()
 				if z.EncBasicHandle().TimeBuiltin() {
 					r.EncodeTime(x.CreatedAt)
-				} else if yyxt24 := z.Extension(x.CreatedAt); yyxt24 != nil {
-					z.EncExtension(x.CreatedAt, yyxt24)
+				} else if yyxt31 := z.Extension(x.CreatedAt); yyxt31 != nil {
+					z.EncExtension(x.CreatedAt, yyxt31)
 				} else if z.EncBinary() {
 					z.EncBinaryMarshal(x.CreatedAt)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -178,12 +199,26 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				z.EncWriteMapElemValue()
 				r.EncodeString(string(x.Email))
 				z.EncWriteMapElemKey()
+				r.EncodeString(`email_day`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.EmailDay)
+				} else if yyxt33 := z.Extension(x.EmailDay); yyxt33 != nil {
+					z.EncExtension(x.EmailDay, yyxt33)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.EmailDay)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.EmailDay)
+				} else {
+					z.EncFallback(x.EmailDay)
+				}
+				z.EncWriteMapElemKey()
 				r.EncodeString(`email_verification_last`)
 				z.EncWriteMapElemValue()
 				if z.EncBasicHandle().TimeBuiltin() {
 					r.EncodeTime(x.EmailVerificationLast)
-				} else if yyxt26 := z.Extension(x.EmailVerificationLast); yyxt26 != nil {
-					z.EncExtension(x.EmailVerificationLast, yyxt26)
+				} else if yyxt34 := z.Extension(x.EmailVerificationLast); yyxt34 != nil {
+					z.EncExtension(x.EmailVerificationLast, yyxt34)
 				} else if z.EncBinary() {
 					z.EncBinaryMarshal(x.EmailVerificationLast)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -194,30 +229,43 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				z.EncWriteMapElemKey()
 				r.EncodeString(`email_verification_token`)
 				z.EncWriteMapElemValue()
-				yy27 := &x.EmailVerificationToken
-				h.encArray32uint8((*[32]uint8)(yy27), e)
+				yy35 := &x.EmailVerificationToken
+				h.encArray32uint8((*[32]uint8)(yy35), e)
 				z.EncWriteMapElemKey()
 				r.EncodeString(`email_verified`)
 				z.EncWriteMapElemValue()
 				r.EncodeBool(bool(x.EmailVerified))
 				z.EncWriteMapElemKey()
+				r.EncodeString(`emails_today`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.EmailsToday))
+				z.EncWriteMapElemKey()
 				r.EncodeString(`id`)
 				z.EncWriteMapElemValue()
-				yy30 := &x.ID
-				if yyxt31 := z.Extension(yy30); yyxt31 != nil {
-					z.EncExtension(yy30, yyxt31)
+				yy39 := &x.ID
+				if yyxt40 := z.Extension(yy39); yyxt40 != nil {
+					z.EncExtension(yy39, yyxt40)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy30)
+					z.EncJSONMarshal(*yy39)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy39[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`quota`)
+				z.EncWriteMapElemValue()
+				yy41 := &x.Quota
+				if yyxt42 := z.Extension(yy41); yyxt42 != nil {
+					z.EncExtension(yy41, yyxt42)
 				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy30[:]), e)
+					yy41.CodecEncodeSelf(e)
 				}
 				z.EncWriteMapElemKey()
 				r.EncodeString(`updated_at`)
 				z.EncWriteMapElemValue()
 				if z.EncBasicHandle().TimeBuiltin() {
 					r.EncodeTime(x.UpdatedAt)
-				} else if yyxt32 := z.Extension(x.UpdatedAt); yyxt32 != nil {
-					z.EncExtension(x.UpdatedAt, yyxt32)
+				} else if yyxt43 := z.Extension(x.UpdatedAt); yyxt43 != nil {
+					z.EncExtension(x.UpdatedAt, yyxt43)
 				} else if z.EncBinary() {
 					z.EncBinaryMarshal(x.UpdatedAt)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -231,8 +279,8 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				z.EncWriteMapElemValue()
 				if z.EncBasicHandle().TimeBuiltin() {
 					r.EncodeTime(x.CreatedAt)
-				} else if yyxt33 := z.Extension(x.CreatedAt); yyxt33 != nil {
-					z.EncExtension(x.CreatedAt, yyxt33)
+				} else if yyxt44 := z.Extension(x.CreatedAt); yyxt44 != nil {
+					z.EncExtension(x.CreatedAt, yyxt44)
 				} else if z.EncBinary() {
 					z.EncBinaryMarshal(x.CreatedAt)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -245,8 +293,8 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				z.EncWriteMapElemValue()
 				if z.EncBasicHandle().TimeBuiltin() {
 					r.EncodeTime(x.UpdatedAt)
-				} else if yyxt34 := z.Extension(x.UpdatedAt); yyxt34 != nil {
-					z.EncExtension(x.UpdatedAt, yyxt34)
+				} else if yyxt45 := z.Extension(x.UpdatedAt); yyxt45 != nil {
+					z.EncExtension(x.UpdatedAt, yyxt45)
 				} else if z.EncBinary() {
 					z.EncBinaryMarshal(x.UpdatedAt)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -257,19 +305,19 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				z.EncWriteMapElemKey()
 				r.EncodeString(`id`)
 				z.EncWriteMapElemValue()
-				yy35 := &x.ID
-				if yyxt36 := z.Extension(yy35); yyxt36 != nil {
-					z.EncExtension(yy35, yyxt36)
+				yy46 := &x.ID
+				if yyxt47 := z.Extension(yy46); yyxt47 != nil {
+					z.EncExtension(yy46, yyxt47)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy35)
+					z.EncJSONMarshal(*yy46)
 				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy35[:]), e)
+					z.F.EncSliceUint8V(([]uint8)(yy46[:]), e)
 				}
 				z.EncWriteMapElemKey()
 				r.EncodeString(`addr`)
 				z.EncWriteMapElemValue()
-				yy37 := &x.Address
-				h.encArray20uint8((*[20]uint8)(yy37), e)
+				yy48 := &x.Address
+				h.encArray20uint8((*[20]uint8)(yy48), e)
 				z.EncWriteMapElemKey()
 				r.EncodeString(`email`)
 				z.EncWriteMapElemValue()
@@ -281,15 +329,15 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				z.EncWriteMapElemKey()
 				r.EncodeString(`email_verification_token`)
 				z.EncWriteMapElemValue()
-				yy41 := &x.EmailVerificationToken
-				h.encArray32uint8((*[32]uint8)(yy41), e)
+				yy52 := &x.EmailVerificationToken
+				h.encArray32uint8((*[32]uint8)(yy52), e)
 				z.EncWriteMapElemKey()
 				r.EncodeString(`email_verification_last`)
 				z.EncWriteMapElemValue()
 				if z.EncBasicHandle().TimeBuiltin() {
 					r.EncodeTime(x.EmailVerificationLast)
-				} else if yyxt43 := z.Extension(x.EmailVerificationLast); yyxt43 != nil {
-					z.EncExtension(x.EmailVerificationLast, yyxt43)
+				} else if yyxt54 := z.Extension(x.EmailVerificationLast); yyxt54 != nil {
+					z.EncExtension(x.EmailVerificationLast, yyxt54)
 				} else if z.EncBinary() {
 					z.EncBinaryMarshal(x.EmailVerificationLast)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
@@ -297,6 +345,33 @@ func (x *User) CodecEncodeSelf(e *codec1978.Encoder) {
 				} else {
 					z.EncFallback(x.EmailVerificationLast)
 				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`quota`)
+				z.EncWriteMapElemValue()
+				yy55 := &x.Quota
+				if yyxt56 := z.Extension(yy55); yyxt56 != nil {
+					z.EncExtension(yy55, yyxt56)
+				} else {
+					yy55.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`email_day`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.EmailDay)
+				} else if yyxt57 := z.Extension(x.EmailDay); yyxt57 != nil {
+					z.EncExtension(x.EmailDay, yyxt57)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.EmailDay)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.EmailDay)
+				} else {
+					z.EncFallback(x.EmailDay)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`emails_today`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.EmailsToday))
 			}
 			z.EncWriteMapEnd()
 		}
@@ -390,6 +465,26 @@ func (x *User) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			} else {
 				z.DecFallback(&x.EmailVerificationLast, false)
 			}
+		case "quota":
+			if yyxt19 := z.Extension(x.Quota); yyxt19 != nil {
+				z.DecExtension(&x.Quota, yyxt19)
+			} else {
+				x.Quota.CodecDecodeSelf(d)
+			}
+		case "email_day":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.EmailDay = r.DecodeTime()
+			} else if yyxt21 := z.Extension(x.EmailDay); yyxt21 != nil {
+				z.DecExtension(&x.EmailDay, yyxt21)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.EmailDay)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.EmailDay)
+			} else {
+				z.DecFallback(&x.EmailDay, false)
+			}
+		case "emails_today":
+			x.EmailsToday = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
@@ -400,19 +495,19 @@ func (x *User) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj18 int
-	var yyb18 bool
-	var yyhl18 bool = l >= 0
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	var yyj23 int
+	var yyb23 bool
+	var yyhl23 bool = l >= 0
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if z.DecBasicHandle().TimeBuiltin() {
 		x.CreatedAt = r.DecodeTime()
-	} else if yyxt20 := z.Extension(x.CreatedAt); yyxt20 != nil {
-		z.DecExtension(&x.CreatedAt, yyxt20)
+	} else if yyxt25 := z.Extension(x.CreatedAt); yyxt25 != nil {
+		z.DecExtension(&x.CreatedAt, yyxt25)
 	} else if z.DecBinary() {
 		z.DecBinaryUnmarshal(&x.CreatedAt)
 	} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -420,17 +515,17 @@ func (x *User) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		z.DecFallback(&x.CreatedAt, false)
 	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if z.DecBasicHandle().TimeBuiltin() {
 		x.UpdatedAt = r.DecodeTime()
-	} else if yyxt22 := z.Extension(x.UpdatedAt); yyxt22 != nil {
-		z.DecExtension(&x.UpdatedAt, yyxt22)
+	} else if yyxt27 := z.Extension(x.UpdatedAt); yyxt27 != nil {
+		z.DecExtension(&x.UpdatedAt, yyxt27)
 	} else if z.DecBinary() {
 		z.DecBinaryUnmarshal(&x.UpdatedAt)
 	} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -438,63 +533,63 @@ func (x *User) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		z.DecFallback(&x.UpdatedAt, false)
 	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt24 := z.Extension(x.ID); yyxt24 != nil {
-		z.DecExtension(&x.ID, yyxt24)
+	if yyxt29 := z.Extension(x.ID); yyxt29 != nil {
+		z.DecExtension(&x.ID, yyxt29)
 	} else if !z.DecBinary() && z.IsJSONHandle() {
 		z.DecJSONUnmarshal(&x.ID)
 	} else {
 		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
 	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray20uint8((*[20]uint8)(&x.Address), d)
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.Email = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.EmailVerified = (bool)(r.DecodeBool())
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray32uint8((*[32]uint8)(&x.EmailVerificationToken), d)
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if z.DecBasicHandle().TimeBuiltin() {
 		x.EmailVerificationLast = r.DecodeTime()
-	} else if yyxt32 := z.Extension(x.EmailVerificationLast); yyxt32 != nil {
-		z.DecExtension(&x.EmailVerificationLast, yyxt32)
+	} else if yyxt37 := z.Extension(x.EmailVerificationLast); yyxt37 != nil {
+		z.DecExtension(&x.EmailVerificationLast, yyxt37)
 	} else if z.DecBinary() {
 		z.DecBinaryUnmarshal(&x.EmailVerificationLast)
 	} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -502,19 +597,57 @@ func (x *User) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		z.DecFallback(&x.EmailVerificationLast, false)
 	}
-	yyj18++
-	for ; z.DecContainerNext(yyj18, l, yyhl18); yyj18++ {
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt39 := z.Extension(x.Quota); yyxt39 != nil {
+		z.DecExtension(&x.Quota, yyxt39)
+	} else {
+		x.Quota.CodecDecodeSelf(d)
+	}
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.EmailDay = r.DecodeTime()
+	} else if yyxt41 := z.Extension(x.EmailDay); yyxt41 != nil {
+		z.DecExtension(&x.EmailDay, yyxt41)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.EmailDay)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.EmailDay)
+	} else {
+		z.DecFallback(&x.EmailDay, false)
+	}
+	yyj23++
+	yyb23 = !z.DecContainerNext(yyj23, l, yyhl23)
+	if yyb23 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.EmailsToday = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj23++
+	for ; z.DecContainerNext(yyj23, l, yyhl23); yyj23++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj18-1, "")
+		z.DecStructFieldNotFound(yyj23-1, "")
 	}
 }
 
 func (x *User) IsCodecEmpty() bool {
-	return !(!(x.CreatedAt.IsZero()) || !(x.UpdatedAt.IsZero()) || x.ID != pkg1_primitive.ObjectID{} || x.Address != [20]uint8{} || x.Email != "" || bool(x.EmailVerified) || x.EmailVerificationToken != [32]uint8{} || !(x.EmailVerificationLast.IsZero()) || false)
+	return !(!(x.CreatedAt.IsZero()) || !(x.UpdatedAt.IsZero()) || x.ID != pkg1_primitive.ObjectID{} || x.Address != [20]uint8{} || x.Email != "" || bool(x.EmailVerified) || x.EmailVerificationToken != [32]uint8{} || !(x.EmailVerificationLast.IsZero()) || !(x.Quota.IsCodecEmpty()) || !(x.EmailDay.IsZero()) || x.EmailsToday != 0 || false)
 }
 
-func (Feed) codecSelferViaCodecgen() {}
-func (x *Feed) CodecEncodeSelf(e *codec1978.Encoder) {
+func (Quota) codecSelferViaCodecgen() {}
+func (x *Quota) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -529,249 +662,67 @@ func (x *Feed) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(8)
-			z.EncWriteArrayElem()
-			if z.EncBasicHandle().TimeBuiltin() {
-				r.EncodeTime(x.CreatedAt)
-			} else if yyxt11 := z.Extension(x.CreatedAt); yyxt11 != nil {
-				z.EncExtension(x.CreatedAt, yyxt11)
-			} else if z.EncBinary() {
-				z.EncBinaryMarshal(x.CreatedAt)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(x.CreatedAt)
-			} else {
-				z.EncFallback(x.CreatedAt)
-			}
-			z.EncWriteArrayElem()
-			if z.EncBasicHandle().TimeBuiltin() {
-				r.EncodeTime(x.UpdatedAt)
-			} else if yyxt12 := z.Extension(x.UpdatedAt); yyxt12 != nil {
-				z.EncExtension(x.UpdatedAt, yyxt12)
-			} else if z.EncBinary() {
-				z.EncBinaryMarshal(x.UpdatedAt)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(x.UpdatedAt)
-			} else {
-				z.EncFallback(x.UpdatedAt)
-			}
-			z.EncWriteArrayElem()
-			yy13 := &x.ID
-			if yyxt14 := z.Extension(yy13); yyxt14 != nil {
-				z.EncExtension(yy13, yyxt14)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(*yy13)
-			} else {
-				z.F.EncSliceUint8V(([]uint8)(yy13[:]), e)
-			}
-			z.EncWriteArrayElem()
-			yy15 := &x.Owner
-			if yyxt16 := z.Extension(yy15); yyxt16 != nil {
-				z.EncExtension(yy15, yyxt16)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(*yy15)
-			} else {
-				z.F.EncSliceUint8V(([]uint8)(yy15[:]), e)
-			}
-			z.EncWriteArrayElem()
-			r.EncodeString(string(x.Name))
+			z.EncWriteArrayStart(3)
 			z.EncWriteArrayElem()
-			r.EncodeString(string(x.URL))
+			r.EncodeUint(uint64(x.MaxFeeds))
 			z.EncWriteArrayElem()
-			if yyxt19 := z.Extension(x.Frequency); yyxt19 != nil {
-				z.EncExtension(x.Frequency, yyxt19)
+			if yyxt7 := z.Extension(x.MinimumFrequency); yyxt7 != nil {
+				z.EncExtension(x.MinimumFrequency, yyxt7)
 			} else {
-				r.EncodeInt(int64(x.Frequency))
+				r.EncodeInt(int64(x.MinimumFrequency))
 			}
 			z.EncWriteArrayElem()
-			if z.EncBasicHandle().TimeBuiltin() {
-				r.EncodeTime(x.LastFetched)
-			} else if yyxt20 := z.Extension(x.LastFetched); yyxt20 != nil {
-				z.EncExtension(x.LastFetched, yyxt20)
-			} else if z.EncBinary() {
-				z.EncBinaryMarshal(x.LastFetched)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(x.LastFetched)
-			} else {
-				z.EncFallback(x.LastFetched)
-			}
+			r.EncodeUint(uint64(x.MaxEmailsPerDay))
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(8)
+			z.EncWriteMapStart(3)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`created_at`)
-				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.CreatedAt)
-				} else if yyxt21 := z.Extension(x.CreatedAt); yyxt21 != nil {
-					z.EncExtension(x.CreatedAt, yyxt21)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.CreatedAt)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.CreatedAt)
-				} else {
-					z.EncFallback(x.CreatedAt)
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`feed_url`)
-				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.URL))
-				z.EncWriteMapElemKey()
-				r.EncodeString(`frequency`)
-				z.EncWriteMapElemValue()
-				if yyxt23 := z.Extension(x.Frequency); yyxt23 != nil {
-					z.EncExtension(x.Frequency, yyxt23)
-				} else {
-					r.EncodeInt(int64(x.Frequency))
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
-				z.EncWriteMapElemValue()
-				yy24 := &x.ID
-				if yyxt25 := z.Extension(yy24); yyxt25 != nil {
-					z.EncExtension(yy24, yyxt25)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy24)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy24[:]), e)
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`last_fetched`)
-				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.LastFetched)
-				} else if yyxt26 := z.Extension(x.LastFetched); yyxt26 != nil {
-					z.EncExtension(x.LastFetched, yyxt26)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.LastFetched)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.LastFetched)
-				} else {
-					z.EncFallback(x.LastFetched)
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`name`)
+				r.EncodeString(`max_emails_per_day`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Name))
+				r.EncodeUint(uint64(x.MaxEmailsPerDay))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`owner_id`)
+				r.EncodeString(`max_feeds`)
 				z.EncWriteMapElemValue()
-				yy28 := &x.Owner
-				if yyxt29 := z.Extension(yy28); yyxt29 != nil {
-					z.EncExtension(yy28, yyxt29)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy28)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy28[:]), e)
-				}
+				r.EncodeUint(uint64(x.MaxFeeds))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`updated_at`)
+				r.EncodeString(`minimum_frequency`)
 				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.UpdatedAt)
-				} else if yyxt30 := z.Extension(x.UpdatedAt); yyxt30 != nil {
-					z.EncExtension(x.UpdatedAt, yyxt30)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.UpdatedAt)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.UpdatedAt)
+				if yyxt11 := z.Extension(x.MinimumFrequency); yyxt11 != nil {
+					z.EncExtension(x.MinimumFrequency, yyxt11)
 				} else {
-					z.EncFallback(x.UpdatedAt)
+					r.EncodeInt(int64(x.MinimumFrequency))
 				}
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`created_at`)
-				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.CreatedAt)
-				} else if yyxt31 := z.Extension(x.CreatedAt); yyxt31 != nil {
-					z.EncExtension(x.CreatedAt, yyxt31)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.CreatedAt)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.CreatedAt)
-				} else {
-					z.EncFallback(x.CreatedAt)
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`updated_at`)
-				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.UpdatedAt)
-				} else if yyxt32 := z.Extension(x.UpdatedAt); yyxt32 != nil {
-					z.EncExtension(x.UpdatedAt, yyxt32)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.UpdatedAt)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.UpdatedAt)
-				} else {
-					z.EncFallback(x.UpdatedAt)
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
+				r.EncodeString(`max_feeds`)
 				z.EncWriteMapElemValue()
-				yy33 := &x.ID
-				if yyxt34 := z.Extension(yy33); yyxt34 != nil {
-					z.EncExtension(yy33, yyxt34)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy33)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy33[:]), e)
-				}
+				r.EncodeUint(uint64(x.MaxFeeds))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`owner_id`)
+				r.EncodeString(`minimum_frequency`)
 				z.EncWriteMapElemValue()
-				yy35 := &x.Owner
-				if yyxt36 := z.Extension(yy35); yyxt36 != nil {
-					z.EncExtension(yy35, yyxt36)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy35)
+				if yyxt13 := z.Extension(x.MinimumFrequency); yyxt13 != nil {
+					z.EncExtension(x.MinimumFrequency, yyxt13)
 				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy35[:]), e)
+					r.EncodeInt(int64(x.MinimumFrequency))
 				}
 				z.EncWriteMapElemKey()
-				r.EncodeString(`name`)
-				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Name))
-				z.EncWriteMapElemKey()
-				r.EncodeString(`feed_url`)
+				r.EncodeString(`max_emails_per_day`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.URL))
-				z.EncWriteMapElemKey()
-				r.EncodeString(`frequency`)
-				z.EncWriteMapElemValue()
-				if yyxt39 := z.Extension(x.Frequency); yyxt39 != nil {
-					z.EncExtension(x.Frequency, yyxt39)
-				} else {
-					r.EncodeInt(int64(x.Frequency))
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`last_fetched`)
-				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.LastFetched)
-				} else if yyxt40 := z.Extension(x.LastFetched); yyxt40 != nil {
-					z.EncExtension(x.LastFetched, yyxt40)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.LastFetched)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.LastFetched)
-				} else {
-					z.EncFallback(x.LastFetched)
-				}
+				r.EncodeUint(uint64(x.MaxEmailsPerDay))
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *Feed) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *Quota) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = Feed{}
+		*(x) = Quota{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -790,7 +741,7 @@ func (x *Feed) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *Feed) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *Quota) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -800,203 +751,69 @@ func (x *Feed) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "created_at":
-			if z.DecBasicHandle().TimeBuiltin() {
-				x.CreatedAt = r.DecodeTime()
-			} else if yyxt5 := z.Extension(x.CreatedAt); yyxt5 != nil {
-				z.DecExtension(&x.CreatedAt, yyxt5)
-			} else if z.DecBinary() {
-				z.DecBinaryUnmarshal(&x.CreatedAt)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.CreatedAt)
-			} else {
-				z.DecFallback(&x.CreatedAt, false)
-			}
-		case "updated_at":
-			if z.DecBasicHandle().TimeBuiltin() {
-				x.UpdatedAt = r.DecodeTime()
-			} else if yyxt7 := z.Extension(x.UpdatedAt); yyxt7 != nil {
-				z.DecExtension(&x.UpdatedAt, yyxt7)
-			} else if z.DecBinary() {
-				z.DecBinaryUnmarshal(&x.UpdatedAt)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.UpdatedAt)
-			} else {
-				z.DecFallback(&x.UpdatedAt, false)
-			}
-		case "id":
-			if yyxt9 := z.Extension(x.ID); yyxt9 != nil {
-				z.DecExtension(&x.ID, yyxt9)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.ID)
-			} else {
-				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
-			}
-		case "owner_id":
-			if yyxt11 := z.Extension(x.Owner); yyxt11 != nil {
-				z.DecExtension(&x.Owner, yyxt11)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.Owner)
-			} else {
-				z.F.DecSliceUint8N(([]uint8)(x.Owner[:]), d)
-			}
-		case "name":
-			x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-		case "feed_url":
-			x.URL = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-		case "frequency":
-			if yyxt15 := z.Extension(x.Frequency); yyxt15 != nil {
-				z.DecExtension(&x.Frequency, yyxt15)
-			} else {
-				x.Frequency = (time.Duration)(r.DecodeInt64())
-			}
-		case "last_fetched":
-			if z.DecBasicHandle().TimeBuiltin() {
-				x.LastFetched = r.DecodeTime()
-			} else if yyxt17 := z.Extension(x.LastFetched); yyxt17 != nil {
-				z.DecExtension(&x.LastFetched, yyxt17)
-			} else if z.DecBinary() {
-				z.DecBinaryUnmarshal(&x.LastFetched)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.LastFetched)
+		case "max_feeds":
+			x.MaxFeeds = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "minimum_frequency":
+			if yyxt6 := z.Extension(x.MinimumFrequency); yyxt6 != nil {
+				z.DecExtension(&x.MinimumFrequency, yyxt6)
 			} else {
-				z.DecFallback(&x.LastFetched, false)
+				x.MinimumFrequency = (time.Duration)(r.DecodeInt64())
 			}
+		case "max_emails_per_day":
+			x.MaxEmailsPerDay = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *Feed) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *Quota) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj18 int
-	var yyb18 bool
-	var yyhl18 bool = l >= 0
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if z.DecBasicHandle().TimeBuiltin() {
-		x.CreatedAt = r.DecodeTime()
-	} else if yyxt20 := z.Extension(x.CreatedAt); yyxt20 != nil {
-		z.DecExtension(&x.CreatedAt, yyxt20)
-	} else if z.DecBinary() {
-		z.DecBinaryUnmarshal(&x.CreatedAt)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.CreatedAt)
-	} else {
-		z.DecFallback(&x.CreatedAt, false)
-	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if z.DecBasicHandle().TimeBuiltin() {
-		x.UpdatedAt = r.DecodeTime()
-	} else if yyxt22 := z.Extension(x.UpdatedAt); yyxt22 != nil {
-		z.DecExtension(&x.UpdatedAt, yyxt22)
-	} else if z.DecBinary() {
-		z.DecBinaryUnmarshal(&x.UpdatedAt)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.UpdatedAt)
-	} else {
-		z.DecFallback(&x.UpdatedAt, false)
-	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if yyxt24 := z.Extension(x.ID); yyxt24 != nil {
-		z.DecExtension(&x.ID, yyxt24)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.ID)
-	} else {
-		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
-	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if yyxt26 := z.Extension(x.Owner); yyxt26 != nil {
-		z.DecExtension(&x.Owner, yyxt26)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.Owner)
-	} else {
-		z.F.DecSliceUint8N(([]uint8)(x.Owner[:]), d)
-	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	var yyj8 int
+	var yyb8 bool
+	var yyhl8 bool = l >= 0
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.URL = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	x.MaxFeeds = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt30 := z.Extension(x.Frequency); yyxt30 != nil {
-		z.DecExtension(&x.Frequency, yyxt30)
+	if yyxt11 := z.Extension(x.MinimumFrequency); yyxt11 != nil {
+		z.DecExtension(&x.MinimumFrequency, yyxt11)
 	} else {
-		x.Frequency = (time.Duration)(r.DecodeInt64())
+		x.MinimumFrequency = (time.Duration)(r.DecodeInt64())
 	}
-	yyj18++
-	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
-	if yyb18 {
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if z.DecBasicHandle().TimeBuiltin() {
-		x.LastFetched = r.DecodeTime()
-	} else if yyxt32 := z.Extension(x.LastFetched); yyxt32 != nil {
-		z.DecExtension(&x.LastFetched, yyxt32)
-	} else if z.DecBinary() {
-		z.DecBinaryUnmarshal(&x.LastFetched)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.LastFetched)
-	} else {
-		z.DecFallback(&x.LastFetched, false)
-	}
-	yyj18++
-	for ; z.DecContainerNext(yyj18, l, yyhl18); yyj18++ {
+	x.MaxEmailsPerDay = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj8++
+	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj18-1, "")
+		z.DecStructFieldNotFound(yyj8-1, "")
 	}
 }
 
-func (x *Feed) IsCodecEmpty() bool {
-	return !(!(x.CreatedAt.IsZero()) || !(x.UpdatedAt.IsZero()) || x.ID != pkg1_primitive.ObjectID{} || x.Owner != pkg1_primitive.ObjectID{} || x.Name != "" || x.URL != "" || x.Frequency != 0 || !(x.LastFetched.IsZero()) || false)
+func (x *Quota) IsCodecEmpty() bool {
+	return !(x.MaxFeeds != 0 || x.MinimumFrequency != 0 || x.MaxEmailsPerDay != 0 || false)
 }
 
-func (SeenItem) codecSelferViaCodecgen() {}
-func (x *SeenItem) CodecEncodeSelf(e *codec1978.Encoder) {
+func (Usage) codecSelferViaCodecgen() {}
+func (x *Usage) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -1011,137 +828,45 @@ func (x *SeenItem) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(4)
-			z.EncWriteArrayElem()
-			yy7 := &x.ID
-			if yyxt8 := z.Extension(yy7); yyxt8 != nil {
-				z.EncExtension(yy7, yyxt8)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(*yy7)
-			} else {
-				z.F.EncSliceUint8V(([]uint8)(yy7[:]), e)
-			}
-			z.EncWriteArrayElem()
-			yy9 := &x.FeedID
-			if yyxt10 := z.Extension(yy9); yyxt10 != nil {
-				z.EncExtension(yy9, yyxt10)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(*yy9)
-			} else {
-				z.F.EncSliceUint8V(([]uint8)(yy9[:]), e)
-			}
+			z.EncWriteArrayStart(2)
 			z.EncWriteArrayElem()
-			r.EncodeString(string(x.GUID))
+			r.EncodeUint(uint64(x.Feeds))
 			z.EncWriteArrayElem()
-			if z.EncBasicHandle().TimeBuiltin() {
-				r.EncodeTime(x.Timestamp)
-			} else if yyxt12 := z.Extension(x.Timestamp); yyxt12 != nil {
-				z.EncExtension(x.Timestamp, yyxt12)
-			} else if z.EncBinary() {
-				z.EncBinaryMarshal(x.Timestamp)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(x.Timestamp)
-			} else {
-				z.EncFallback(x.Timestamp)
-			}
+			r.EncodeUint(uint64(x.EmailsToday))
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(4)
+			z.EncWriteMapStart(2)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`feed_id`)
-				z.EncWriteMapElemValue()
-				yy13 := &x.FeedID
-				if yyxt14 := z.Extension(yy13); yyxt14 != nil {
-					z.EncExtension(yy13, yyxt14)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy13)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy13[:]), e)
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`guid`)
-				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.GUID))
-				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
+				r.EncodeString(`emails_today`)
 				z.EncWriteMapElemValue()
-				yy16 := &x.ID
-				if yyxt17 := z.Extension(yy16); yyxt17 != nil {
-					z.EncExtension(yy16, yyxt17)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy16)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy16[:]), e)
-				}
+				r.EncodeUint(uint64(x.EmailsToday))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`timestamp`)
+				r.EncodeString(`feeds`)
 				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.Timestamp)
-				} else if yyxt18 := z.Extension(x.Timestamp); yyxt18 != nil {
-					z.EncExtension(x.Timestamp, yyxt18)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.Timestamp)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.Timestamp)
-				} else {
-					z.EncFallback(x.Timestamp)
-				}
+				r.EncodeUint(uint64(x.Feeds))
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
+				r.EncodeString(`feeds`)
 				z.EncWriteMapElemValue()
-				yy19 := &x.ID
-				if yyxt20 := z.Extension(yy19); yyxt20 != nil {
-					z.EncExtension(yy19, yyxt20)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy19)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy19[:]), e)
-				}
+				r.EncodeUint(uint64(x.Feeds))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`feed_id`)
+				r.EncodeString(`emails_today`)
 				z.EncWriteMapElemValue()
-				yy21 := &x.FeedID
-				if yyxt22 := z.Extension(yy21); yyxt22 != nil {
-					z.EncExtension(yy21, yyxt22)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy21)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy21[:]), e)
-				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`guid`)
-				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.GUID))
-				z.EncWriteMapElemKey()
-				r.EncodeString(`timestamp`)
-				z.EncWriteMapElemValue()
-				if z.EncBasicHandle().TimeBuiltin() {
-					r.EncodeTime(x.Timestamp)
-				} else if yyxt24 := z.Extension(x.Timestamp); yyxt24 != nil {
-					z.EncExtension(x.Timestamp, yyxt24)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.Timestamp)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.Timestamp)
-				} else {
-					z.EncFallback(x.Timestamp)
-				}
+				r.EncodeUint(uint64(x.EmailsToday))
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *SeenItem) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *Usage) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = SeenItem{}
+		*(x) = Usage{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -1160,7 +885,7 @@ func (x *SeenItem) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *SeenItem) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *Usage) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -1170,115 +895,51 @@ func (x *SeenItem) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "id":
-			if yyxt5 := z.Extension(x.ID); yyxt5 != nil {
-				z.DecExtension(&x.ID, yyxt5)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.ID)
-			} else {
-				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
-			}
-		case "feed_id":
-			if yyxt7 := z.Extension(x.FeedID); yyxt7 != nil {
-				z.DecExtension(&x.FeedID, yyxt7)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.FeedID)
-			} else {
-				z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
-			}
-		case "guid":
-			x.GUID = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-		case "timestamp":
-			if z.DecBasicHandle().TimeBuiltin() {
-				x.Timestamp = r.DecodeTime()
-			} else if yyxt10 := z.Extension(x.Timestamp); yyxt10 != nil {
-				z.DecExtension(&x.Timestamp, yyxt10)
-			} else if z.DecBinary() {
-				z.DecBinaryUnmarshal(&x.Timestamp)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.Timestamp)
-			} else {
-				z.DecFallback(&x.Timestamp, false)
-			}
+		case "feeds":
+			x.Feeds = (uint64)(r.DecodeUint64())
+		case "emails_today":
+			x.EmailsToday = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *SeenItem) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *Usage) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj11 int
-	var yyb11 bool
-	var yyhl11 bool = l >= 0
-	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
-	if yyb11 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if yyxt13 := z.Extension(x.ID); yyxt13 != nil {
-		z.DecExtension(&x.ID, yyxt13)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.ID)
-	} else {
-		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
-	}
-	yyj11++
-	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
-	if yyb11 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if yyxt15 := z.Extension(x.FeedID); yyxt15 != nil {
-		z.DecExtension(&x.FeedID, yyxt15)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.FeedID)
-	} else {
-		z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
-	}
-	yyj11++
-	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
-	if yyb11 {
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.GUID = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj11++
-	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
-	if yyb11 {
+	x.Feeds = (uint64)(r.DecodeUint64())
+	yyj6++
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if z.DecBasicHandle().TimeBuiltin() {
-		x.Timestamp = r.DecodeTime()
-	} else if yyxt18 := z.Extension(x.Timestamp); yyxt18 != nil {
-		z.DecExtension(&x.Timestamp, yyxt18)
-	} else if z.DecBinary() {
-		z.DecBinaryUnmarshal(&x.Timestamp)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.Timestamp)
-	} else {
-		z.DecFallback(&x.Timestamp, false)
-	}
-	yyj11++
-	for ; z.DecContainerNext(yyj11, l, yyhl11); yyj11++ {
+	x.EmailsToday = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj11-1, "")
+		z.DecStructFieldNotFound(yyj6-1, "")
 	}
 }
 
-func (x *SeenItem) IsCodecEmpty() bool {
-	return !(x.ID != pkg1_primitive.ObjectID{} || x.FeedID != pkg1_primitive.ObjectID{} || x.GUID != "" || !(x.Timestamp.IsZero()) || false)
+func (x *Usage) IsCodecEmpty() bool {
+	return !(x.Feeds != 0 || x.EmailsToday != 0 || false)
 }
 
-func (ErrorMessage) codecSelferViaCodecgen() {}
-func (x *ErrorMessage) CodecEncodeSelf(e *codec1978.Encoder) {
+func (Feed) codecSelferViaCodecgen() {}
+func (x *Feed) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -1291,87 +952,433 @@ func (x *ErrorMessage) CodecEncodeSelf(e *codec1978.Encoder) {
 	} else {
 		yy2arr2 := z.EncBasicHandle().StructToArray
 		_ = yy2arr2
-		const yyr2 bool = true // struct tag has 'toArray'
-		var yyq2 = [2]bool{    // should field at this index be written?
-			x.Code != 0,     // Code
-			x.Message != "", // Message
-		}
-		_ = yyq2
+		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(2)
+			z.EncWriteArrayStart(19)
 			z.EncWriteArrayElem()
-			if yyq2[0] {
-				if yyxt5 := z.Extension(x.Code); yyxt5 != nil {
-					z.EncExtension(x.Code, yyxt5)
-				} else {
-					r.EncodeUint(uint64(x.Code))
-				}
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.CreatedAt)
+			} else if yyxt22 := z.Extension(x.CreatedAt); yyxt22 != nil {
+				z.EncExtension(x.CreatedAt, yyxt22)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.CreatedAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.CreatedAt)
 			} else {
-				r.EncodeUint(0)
+				z.EncFallback(x.CreatedAt)
 			}
 			z.EncWriteArrayElem()
-			if yyq2[1] {
-				r.EncodeString(string(x.Message))
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.UpdatedAt)
+			} else if yyxt23 := z.Extension(x.UpdatedAt); yyxt23 != nil {
+				z.EncExtension(x.UpdatedAt, yyxt23)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.UpdatedAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.UpdatedAt)
 			} else {
-				r.EncodeString("")
+				z.EncFallback(x.UpdatedAt)
+			}
+			z.EncWriteArrayElem()
+			yy24 := &x.ID
+			if yyxt25 := z.Extension(yy24); yyxt25 != nil {
+				z.EncExtension(yy24, yyxt25)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy24)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy24[:]), e)
+			}
+			z.EncWriteArrayElem()
+			yy26 := &x.Owner
+			if yyxt27 := z.Extension(yy26); yyxt27 != nil {
+				z.EncExtension(yy26, yyxt27)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy26)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy26[:]), e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Name))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.URL))
+			z.EncWriteArrayElem()
+			if yyxt30 := z.Extension(x.Frequency); yyxt30 != nil {
+				z.EncExtension(x.Frequency, yyxt30)
+			} else {
+				r.EncodeInt(int64(x.Frequency))
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.LastFetched)
+			} else if yyxt31 := z.Extension(x.LastFetched); yyxt31 != nil {
+				z.EncExtension(x.LastFetched, yyxt31)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.LastFetched)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.LastFetched)
+			} else {
+				z.EncFallback(x.LastFetched)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.LastError))
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.FailingSince)
+			} else if yyxt33 := z.Extension(x.FailingSince); yyxt33 != nil {
+				z.EncExtension(x.FailingSince, yyxt33)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.FailingSince)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.FailingSince)
+			} else {
+				z.EncFallback(x.FailingSince)
 			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.FirstFetched)
+			} else if yyxt34 := z.Extension(x.FirstFetched); yyxt34 != nil {
+				z.EncExtension(x.FirstFetched, yyxt34)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.FirstFetched)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.FirstFetched)
+			} else {
+				z.EncFallback(x.FirstFetched)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Identity))
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.NotifyUpdates))
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.FullText))
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.AttachEnclosures))
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Images))
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Delivery))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Query))
+			z.EncWriteArrayElem()
+			if x.SourceFeeds == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.SourceFeeds), e)
+			} // end block: if x.SourceFeeds slice == nil
 			z.EncWriteArrayEnd()
 		} else {
-			var yynn2 int
-			for _, b := range yyq2 {
-				if b {
-					yynn2++
-				}
-			}
-			z.EncWriteMapStart(yynn2)
-			yynn2 = 0
+			z.EncWriteMapStart(19)
 			if z.EncBasicHandle().Canonical {
-				if yyq2[0] {
-					z.EncWriteMapElemKey()
-					r.EncodeString(`Code`)
-					z.EncWriteMapElemValue()
-					if yyxt7 := z.Extension(x.Code); yyxt7 != nil {
-						z.EncExtension(x.Code, yyxt7)
-					} else {
-						r.EncodeUint(uint64(x.Code))
-					}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`attach_enclosures`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.AttachEnclosures))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`created_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.CreatedAt)
+				} else if yyxt44 := z.Extension(x.CreatedAt); yyxt44 != nil {
+					z.EncExtension(x.CreatedAt, yyxt44)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.CreatedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.CreatedAt)
+				} else {
+					z.EncFallback(x.CreatedAt)
 				}
-				if yyq2[1] {
-					z.EncWriteMapElemKey()
-					r.EncodeString(`Message`)
-					z.EncWriteMapElemValue()
-					r.EncodeString(string(x.Message))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`delivery`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Delivery))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`failing_since`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.FailingSince)
+				} else if yyxt46 := z.Extension(x.FailingSince); yyxt46 != nil {
+					z.EncExtension(x.FailingSince, yyxt46)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.FailingSince)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.FailingSince)
+				} else {
+					z.EncFallback(x.FailingSince)
 				}
-			} else {
-				if yyq2[0] {
-					z.EncWriteMapElemKey()
-					r.EncodeString(`Code`)
-					z.EncWriteMapElemValue()
-					if yyxt9 := z.Extension(x.Code); yyxt9 != nil {
-						z.EncExtension(x.Code, yyxt9)
-					} else {
-						r.EncodeUint(uint64(x.Code))
-					}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_url`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.URL))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`first_fetched`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.FirstFetched)
+				} else if yyxt48 := z.Extension(x.FirstFetched); yyxt48 != nil {
+					z.EncExtension(x.FirstFetched, yyxt48)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.FirstFetched)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.FirstFetched)
+				} else {
+					z.EncFallback(x.FirstFetched)
 				}
-				if yyq2[1] {
-					z.EncWriteMapElemKey()
-					r.EncodeString(`Message`)
-					z.EncWriteMapElemValue()
-					r.EncodeString(string(x.Message))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`frequency`)
+				z.EncWriteMapElemValue()
+				if yyxt49 := z.Extension(x.Frequency); yyxt49 != nil {
+					z.EncExtension(x.Frequency, yyxt49)
+				} else {
+					r.EncodeInt(int64(x.Frequency))
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`full_text`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.FullText))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy51 := &x.ID
+				if yyxt52 := z.Extension(yy51); yyxt52 != nil {
+					z.EncExtension(yy51, yyxt52)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy51)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy51[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`identity`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Identity))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`images`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Images))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`last_error`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.LastError))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`last_fetched`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.LastFetched)
+				} else if yyxt56 := z.Extension(x.LastFetched); yyxt56 != nil {
+					z.EncExtension(x.LastFetched, yyxt56)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.LastFetched)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.LastFetched)
+				} else {
+					z.EncFallback(x.LastFetched)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Name))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`notify_updates`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.NotifyUpdates))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`owner_id`)
+				z.EncWriteMapElemValue()
+				yy59 := &x.Owner
+				if yyxt60 := z.Extension(yy59); yyxt60 != nil {
+					z.EncExtension(yy59, yyxt60)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy59)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy59[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`query`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Query))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`source_feeds`)
+				z.EncWriteMapElemValue()
+				if x.SourceFeeds == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.SourceFeeds), e)
+				} // end block: if x.SourceFeeds slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`updated_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.UpdatedAt)
+				} else if yyxt63 := z.Extension(x.UpdatedAt); yyxt63 != nil {
+					z.EncExtension(x.UpdatedAt, yyxt63)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.UpdatedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.UpdatedAt)
+				} else {
+					z.EncFallback(x.UpdatedAt)
+				}
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`created_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.CreatedAt)
+				} else if yyxt64 := z.Extension(x.CreatedAt); yyxt64 != nil {
+					z.EncExtension(x.CreatedAt, yyxt64)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.CreatedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.CreatedAt)
+				} else {
+					z.EncFallback(x.CreatedAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`updated_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.UpdatedAt)
+				} else if yyxt65 := z.Extension(x.UpdatedAt); yyxt65 != nil {
+					z.EncExtension(x.UpdatedAt, yyxt65)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.UpdatedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.UpdatedAt)
+				} else {
+					z.EncFallback(x.UpdatedAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy66 := &x.ID
+				if yyxt67 := z.Extension(yy66); yyxt67 != nil {
+					z.EncExtension(yy66, yyxt67)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy66)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy66[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`owner_id`)
+				z.EncWriteMapElemValue()
+				yy68 := &x.Owner
+				if yyxt69 := z.Extension(yy68); yyxt69 != nil {
+					z.EncExtension(yy68, yyxt69)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy68)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy68[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Name))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_url`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.URL))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`frequency`)
+				z.EncWriteMapElemValue()
+				if yyxt72 := z.Extension(x.Frequency); yyxt72 != nil {
+					z.EncExtension(x.Frequency, yyxt72)
+				} else {
+					r.EncodeInt(int64(x.Frequency))
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`last_fetched`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.LastFetched)
+				} else if yyxt73 := z.Extension(x.LastFetched); yyxt73 != nil {
+					z.EncExtension(x.LastFetched, yyxt73)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.LastFetched)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.LastFetched)
+				} else {
+					z.EncFallback(x.LastFetched)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`last_error`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.LastError))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`failing_since`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.FailingSince)
+				} else if yyxt75 := z.Extension(x.FailingSince); yyxt75 != nil {
+					z.EncExtension(x.FailingSince, yyxt75)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.FailingSince)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.FailingSince)
+				} else {
+					z.EncFallback(x.FailingSince)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`first_fetched`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.FirstFetched)
+				} else if yyxt76 := z.Extension(x.FirstFetched); yyxt76 != nil {
+					z.EncExtension(x.FirstFetched, yyxt76)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.FirstFetched)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.FirstFetched)
+				} else {
+					z.EncFallback(x.FirstFetched)
 				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`identity`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Identity))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`notify_updates`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.NotifyUpdates))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`full_text`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.FullText))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`attach_enclosures`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.AttachEnclosures))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`images`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Images))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`delivery`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Delivery))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`query`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Query))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`source_feeds`)
+				z.EncWriteMapElemValue()
+				if x.SourceFeeds == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.SourceFeeds), e)
+				} // end block: if x.SourceFeeds slice == nil
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *ErrorMessage) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *Feed) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = ErrorMessage{}
+		*(x) = Feed{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -1390,7 +1397,7 @@ func (x *ErrorMessage) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *ErrorMessage) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *Feed) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -1400,59 +1407,353 @@ func (x *ErrorMessage) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "Code":
-			if yyxt5 := z.Extension(x.Code); yyxt5 != nil {
-				z.DecExtension(&x.Code, yyxt5)
+		case "created_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.CreatedAt = r.DecodeTime()
+			} else if yyxt5 := z.Extension(x.CreatedAt); yyxt5 != nil {
+				z.DecExtension(&x.CreatedAt, yyxt5)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.CreatedAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.CreatedAt)
 			} else {
-				x.Code = (ErrorCode)(r.DecodeUint64())
+				z.DecFallback(&x.CreatedAt, false)
 			}
-		case "Message":
-			x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "updated_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.UpdatedAt = r.DecodeTime()
+			} else if yyxt7 := z.Extension(x.UpdatedAt); yyxt7 != nil {
+				z.DecExtension(&x.UpdatedAt, yyxt7)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.UpdatedAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.UpdatedAt)
+			} else {
+				z.DecFallback(&x.UpdatedAt, false)
+			}
+		case "id":
+			if yyxt9 := z.Extension(x.ID); yyxt9 != nil {
+				z.DecExtension(&x.ID, yyxt9)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+			}
+		case "owner_id":
+			if yyxt11 := z.Extension(x.Owner); yyxt11 != nil {
+				z.DecExtension(&x.Owner, yyxt11)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Owner)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.Owner[:]), d)
+			}
+		case "name":
+			x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "feed_url":
+			x.URL = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "frequency":
+			if yyxt15 := z.Extension(x.Frequency); yyxt15 != nil {
+				z.DecExtension(&x.Frequency, yyxt15)
+			} else {
+				x.Frequency = (time.Duration)(r.DecodeInt64())
+			}
+		case "last_fetched":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.LastFetched = r.DecodeTime()
+			} else if yyxt17 := z.Extension(x.LastFetched); yyxt17 != nil {
+				z.DecExtension(&x.LastFetched, yyxt17)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.LastFetched)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.LastFetched)
+			} else {
+				z.DecFallback(&x.LastFetched, false)
+			}
+		case "last_error":
+			x.LastError = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "failing_since":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.FailingSince = r.DecodeTime()
+			} else if yyxt20 := z.Extension(x.FailingSince); yyxt20 != nil {
+				z.DecExtension(&x.FailingSince, yyxt20)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.FailingSince)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FailingSince)
+			} else {
+				z.DecFallback(&x.FailingSince, false)
+			}
+		case "first_fetched":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.FirstFetched = r.DecodeTime()
+			} else if yyxt22 := z.Extension(x.FirstFetched); yyxt22 != nil {
+				z.DecExtension(&x.FirstFetched, yyxt22)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.FirstFetched)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FirstFetched)
+			} else {
+				z.DecFallback(&x.FirstFetched, false)
+			}
+		case "identity":
+			x.Identity = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+		case "notify_updates":
+			x.NotifyUpdates = (bool)(r.DecodeBool())
+		case "full_text":
+			x.FullText = (bool)(r.DecodeBool())
+		case "attach_enclosures":
+			x.AttachEnclosures = (bool)(r.DecodeBool())
+		case "images":
+			x.Images = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+		case "delivery":
+			x.Delivery = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+		case "query":
+			x.Query = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "source_feeds":
+			h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.SourceFeeds), d)
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *ErrorMessage) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *Feed) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj7 int
-	var yyb7 bool
-	var yyhl7 bool = l >= 0
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	var yyj32 int
+	var yyb32 bool
+	var yyhl32 bool = l >= 0
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt9 := z.Extension(x.Code); yyxt9 != nil {
-		z.DecExtension(&x.Code, yyxt9)
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.CreatedAt = r.DecodeTime()
+	} else if yyxt34 := z.Extension(x.CreatedAt); yyxt34 != nil {
+		z.DecExtension(&x.CreatedAt, yyxt34)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.CreatedAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.CreatedAt)
 	} else {
-		x.Code = (ErrorCode)(r.DecodeUint64())
+		z.DecFallback(&x.CreatedAt, false)
 	}
-	yyj7++
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj7++
-	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.UpdatedAt = r.DecodeTime()
+	} else if yyxt36 := z.Extension(x.UpdatedAt); yyxt36 != nil {
+		z.DecExtension(&x.UpdatedAt, yyxt36)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.UpdatedAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.UpdatedAt)
+	} else {
+		z.DecFallback(&x.UpdatedAt, false)
+	}
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt38 := z.Extension(x.ID); yyxt38 != nil {
+		z.DecExtension(&x.ID, yyxt38)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	}
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt40 := z.Extension(x.Owner); yyxt40 != nil {
+		z.DecExtension(&x.Owner, yyxt40)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Owner)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.Owner[:]), d)
+	}
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.URL = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt44 := z.Extension(x.Frequency); yyxt44 != nil {
+		z.DecExtension(&x.Frequency, yyxt44)
+	} else {
+		x.Frequency = (time.Duration)(r.DecodeInt64())
+	}
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.LastFetched = r.DecodeTime()
+	} else if yyxt46 := z.Extension(x.LastFetched); yyxt46 != nil {
+		z.DecExtension(&x.LastFetched, yyxt46)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.LastFetched)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.LastFetched)
+	} else {
+		z.DecFallback(&x.LastFetched, false)
+	}
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.LastError = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.FailingSince = r.DecodeTime()
+	} else if yyxt49 := z.Extension(x.FailingSince); yyxt49 != nil {
+		z.DecExtension(&x.FailingSince, yyxt49)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.FailingSince)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FailingSince)
+	} else {
+		z.DecFallback(&x.FailingSince, false)
+	}
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.FirstFetched = r.DecodeTime()
+	} else if yyxt51 := z.Extension(x.FirstFetched); yyxt51 != nil {
+		z.DecExtension(&x.FirstFetched, yyxt51)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.FirstFetched)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FirstFetched)
+	} else {
+		z.DecFallback(&x.FirstFetched, false)
+	}
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Identity = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.NotifyUpdates = (bool)(r.DecodeBool())
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.FullText = (bool)(r.DecodeBool())
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.AttachEnclosures = (bool)(r.DecodeBool())
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Images = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Delivery = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Query = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj32++
+	yyb32 = !z.DecContainerNext(yyj32, l, yyhl32)
+	if yyb32 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.SourceFeeds), d)
+	yyj32++
+	for ; z.DecContainerNext(yyj32, l, yyhl32); yyj32++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj7-1, "")
+		z.DecStructFieldNotFound(yyj32-1, "")
 	}
 }
 
-func (x *ErrorMessage) IsCodecEmpty() bool {
-	return !(x.Code != 0 || x.Message != "" || false)
+func (x *Feed) IsCodecEmpty() bool {
+	return !(!(x.CreatedAt.IsZero()) || !(x.UpdatedAt.IsZero()) || x.ID != pkg1_primitive.ObjectID{} || x.Owner != pkg1_primitive.ObjectID{} || x.Name != "" || x.URL != "" || x.Frequency != 0 || !(x.LastFetched.IsZero()) || x.LastError != "" || !(x.FailingSince.IsZero()) || !(x.FirstFetched.IsZero()) || x.Identity != 0 || bool(x.NotifyUpdates) || bool(x.FullText) || bool(x.AttachEnclosures) || x.Images != 0 || x.Delivery != 0 || x.Query != "" || len(x.SourceFeeds) != 0 || false)
 }
 
-func (InitializationRequest) codecSelferViaCodecgen() {}
-func (x *InitializationRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+func (SeenItem) codecSelferViaCodecgen() {}
+func (x *SeenItem) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -1467,48 +1768,207 @@ func (x *InitializationRequest) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(2)
+			z.EncWriteArrayStart(8)
+			z.EncWriteArrayElem()
+			yy11 := &x.ID
+			if yyxt12 := z.Extension(yy11); yyxt12 != nil {
+				z.EncExtension(yy11, yyxt12)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy11)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy11[:]), e)
+			}
+			z.EncWriteArrayElem()
+			yy13 := &x.FeedID
+			if yyxt14 := z.Extension(yy13); yyxt14 != nil {
+				z.EncExtension(yy13, yyxt14)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy13)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy13[:]), e)
+			}
 			z.EncWriteArrayElem()
-			yy5 := &x.Address
-			h.encArray20uint8((*[20]uint8)(yy5), e)
+			r.EncodeString(string(x.GUID))
 			z.EncWriteArrayElem()
-			r.EncodeString(string(x.Locale))
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.Timestamp)
+			} else if yyxt16 := z.Extension(x.Timestamp); yyxt16 != nil {
+				z.EncExtension(x.Timestamp, yyxt16)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.Timestamp)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.Timestamp)
+			} else {
+				z.EncFallback(x.Timestamp)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.ContentHash))
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.Updated)
+			} else if yyxt18 := z.Extension(x.Updated); yyxt18 != nil {
+				z.EncExtension(x.Updated, yyxt18)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.Updated)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.Updated)
+			} else {
+				z.EncFallback(x.Updated)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Title))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Text))
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(2)
+			z.EncWriteMapStart(8)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`address`)
+				r.EncodeString(`content_hash`)
 				z.EncWriteMapElemValue()
-				yy8 := &x.Address
-				h.encArray20uint8((*[20]uint8)(yy8), e)
+				r.EncodeString(string(x.ContentHash))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`locale`)
+				r.EncodeString(`feed_id`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Locale))
+				yy22 := &x.FeedID
+				if yyxt23 := z.Extension(yy22); yyxt23 != nil {
+					z.EncExtension(yy22, yyxt23)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy22)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy22[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`guid`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.GUID))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy25 := &x.ID
+				if yyxt26 := z.Extension(yy25); yyxt26 != nil {
+					z.EncExtension(yy25, yyxt26)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy25)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy25[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`text`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Text))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`timestamp`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Timestamp)
+				} else if yyxt28 := z.Extension(x.Timestamp); yyxt28 != nil {
+					z.EncExtension(x.Timestamp, yyxt28)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Timestamp)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Timestamp)
+				} else {
+					z.EncFallback(x.Timestamp)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`title`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Title))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`updated`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Updated)
+				} else if yyxt30 := z.Extension(x.Updated); yyxt30 != nil {
+					z.EncExtension(x.Updated, yyxt30)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Updated)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Updated)
+				} else {
+					z.EncFallback(x.Updated)
+				}
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`address`)
+				r.EncodeString(`id`)
 				z.EncWriteMapElemValue()
-				yy11 := &x.Address
-				h.encArray20uint8((*[20]uint8)(yy11), e)
+				yy31 := &x.ID
+				if yyxt32 := z.Extension(yy31); yyxt32 != nil {
+					z.EncExtension(yy31, yyxt32)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy31)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy31[:]), e)
+				}
 				z.EncWriteMapElemKey()
-				r.EncodeString(`locale`)
+				r.EncodeString(`feed_id`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Locale))
+				yy33 := &x.FeedID
+				if yyxt34 := z.Extension(yy33); yyxt34 != nil {
+					z.EncExtension(yy33, yyxt34)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy33)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy33[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`guid`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.GUID))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`timestamp`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Timestamp)
+				} else if yyxt36 := z.Extension(x.Timestamp); yyxt36 != nil {
+					z.EncExtension(x.Timestamp, yyxt36)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Timestamp)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Timestamp)
+				} else {
+					z.EncFallback(x.Timestamp)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`content_hash`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.ContentHash))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`updated`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Updated)
+				} else if yyxt38 := z.Extension(x.Updated); yyxt38 != nil {
+					z.EncExtension(x.Updated, yyxt38)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Updated)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Updated)
+				} else {
+					z.EncFallback(x.Updated)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`title`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Title))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`text`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Text))
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *InitializationRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *SeenItem) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = InitializationRequest{}
+		*(x) = SeenItem{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -1527,7 +1987,7 @@ func (x *InitializationRequest) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *InitializationRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *SeenItem) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -1537,189 +1997,175 @@ func (x *InitializationRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "address":
-			h.decArray20uint8((*[20]uint8)(&x.Address), d)
-		case "locale":
-			x.Locale = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-		default:
-			z.DecStructFieldNotFound(-1, string(yys3))
-		} // end switch yys3
+		case "id":
+			if yyxt5 := z.Extension(x.ID); yyxt5 != nil {
+				z.DecExtension(&x.ID, yyxt5)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+			}
+		case "feed_id":
+			if yyxt7 := z.Extension(x.FeedID); yyxt7 != nil {
+				z.DecExtension(&x.FeedID, yyxt7)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FeedID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+			}
+		case "guid":
+			x.GUID = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "timestamp":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.Timestamp = r.DecodeTime()
+			} else if yyxt10 := z.Extension(x.Timestamp); yyxt10 != nil {
+				z.DecExtension(&x.Timestamp, yyxt10)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.Timestamp)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Timestamp)
+			} else {
+				z.DecFallback(&x.Timestamp, false)
+			}
+		case "content_hash":
+			x.ContentHash = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "updated":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.Updated = r.DecodeTime()
+			} else if yyxt13 := z.Extension(x.Updated); yyxt13 != nil {
+				z.DecExtension(&x.Updated, yyxt13)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.Updated)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Updated)
+			} else {
+				z.DecFallback(&x.Updated, false)
+			}
+		case "title":
+			x.Title = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "text":
+			x.Text = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *InitializationRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *SeenItem) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj7 int
-	var yyb7 bool
-	var yyhl7 bool = l >= 0
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	var yyj16 int
+	var yyb16 bool
+	var yyhl16 bool = l >= 0
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decArray20uint8((*[20]uint8)(&x.Address), d)
-	yyj7++
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	if yyxt18 := z.Extension(x.ID); yyxt18 != nil {
+		z.DecExtension(&x.ID, yyxt18)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	}
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Locale = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj7++
-	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj7-1, "")
+	if yyxt20 := z.Extension(x.FeedID); yyxt20 != nil {
+		z.DecExtension(&x.FeedID, yyxt20)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FeedID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
 	}
-}
-
-func (x *InitializationRequest) IsCodecEmpty() bool {
-	return !(x.Address != [20]uint8{} || x.Locale != "" || false)
-}
-
-func (InitializationResponse) codecSelferViaCodecgen() {}
-func (x *InitializationResponse) CodecEncodeSelf(e *codec1978.Encoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if z.EncBasicHandle().CheckCircularRef {
-		z.EncEncode(x)
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
+		z.DecReadArrayEnd()
 		return
 	}
-	if x == nil {
-		r.EncodeNil()
+	z.DecReadArrayElem()
+	x.GUID = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.Timestamp = r.DecodeTime()
+	} else if yyxt23 := z.Extension(x.Timestamp); yyxt23 != nil {
+		z.DecExtension(&x.Timestamp, yyxt23)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.Timestamp)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Timestamp)
 	} else {
-		yy2arr2 := z.EncBasicHandle().StructToArray
-		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
-		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(2)
-			z.EncWriteArrayElem()
-			r.EncodeBool(bool(x.UserFound))
-			z.EncWriteArrayElem()
-			if x.Challenge == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.Challenge))
-			} // end block: if x.Challenge slice == nil
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(2)
-			if z.EncBasicHandle().Canonical {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`challenge`)
-				z.EncWriteMapElemValue()
-				if x.Challenge == nil {
-					r.EncodeNil()
-				} else {
-					r.EncodeStringBytesRaw([]byte(x.Challenge))
-				} // end block: if x.Challenge slice == nil
-				z.EncWriteMapElemKey()
-				r.EncodeString(`user_found`)
-				z.EncWriteMapElemValue()
-				r.EncodeBool(bool(x.UserFound))
-			} else {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`user_found`)
-				z.EncWriteMapElemValue()
-				r.EncodeBool(bool(x.UserFound))
-				z.EncWriteMapElemKey()
-				r.EncodeString(`challenge`)
-				z.EncWriteMapElemValue()
-				if x.Challenge == nil {
-					r.EncodeNil()
-				} else {
-					r.EncodeStringBytesRaw([]byte(x.Challenge))
-				} // end block: if x.Challenge slice == nil
-			}
-			z.EncWriteMapEnd()
-		}
+		z.DecFallback(&x.Timestamp, false)
 	}
-}
-
-func (x *InitializationResponse) CodecDecodeSelf(d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	yyct2 := r.ContainerType()
-	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = InitializationResponse{}
-	} else if yyct2 == codecSelferValueTypeMap42 {
-		yyl2 := z.DecReadMapStart()
-		if yyl2 == 0 {
-		} else {
-			x.codecDecodeSelfFromMap(yyl2, d)
-		}
-		z.DecReadMapEnd()
-	} else if yyct2 == codecSelferValueTypeArray42 {
-		yyl2 := z.DecReadArrayStart()
-		if yyl2 != 0 {
-			x.codecDecodeSelfFromArray(yyl2, d)
-		}
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.ContentHash = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
 		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.Updated = r.DecodeTime()
+	} else if yyxt26 := z.Extension(x.Updated); yyxt26 != nil {
+		z.DecExtension(&x.Updated, yyxt26)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.Updated)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Updated)
 	} else {
-		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+		z.DecFallback(&x.Updated, false)
 	}
-}
-
-func (x *InitializationResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyhl3 bool = l >= 0
-	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
-		z.DecReadMapElemKey()
-		yys3 := r.DecodeStringAsBytes()
-		z.DecReadMapElemValue()
-		switch string(yys3) {
-		case "user_found":
-			x.UserFound = (bool)(r.DecodeBool())
-		case "challenge":
-			x.Challenge = z.DecodeBytesInto(([]byte)(x.Challenge))
-		default:
-			z.DecStructFieldNotFound(-1, string(yys3))
-		} // end switch yys3
-	} // end for yyj3
-}
-
-func (x *InitializationResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyj7 int
-	var yyb7 bool
-	var yyhl7 bool = l >= 0
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.UserFound = (bool)(r.DecodeBool())
-	yyj7++
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	x.Title = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Challenge = z.DecodeBytesInto(([]byte)(x.Challenge))
-	yyj7++
-	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+	x.Text = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj16++
+	for ; z.DecContainerNext(yyj16, l, yyhl16); yyj16++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj7-1, "")
+		z.DecStructFieldNotFound(yyj16-1, "")
 	}
 }
 
-func (x *InitializationResponse) IsCodecEmpty() bool {
-	return !(bool(x.UserFound) || len(x.Challenge) != 0 || false)
+func (x *SeenItem) IsCodecEmpty() bool {
+	return !(x.ID != pkg1_primitive.ObjectID{} || x.FeedID != pkg1_primitive.ObjectID{} || x.GUID != "" || !(x.Timestamp.IsZero()) || x.ContentHash != "" || !(x.Updated.IsZero()) || x.Title != "" || x.Text != "" || false)
 }
 
-func (NewUserInitialization) codecSelferViaCodecgen() {}
-func (x *NewUserInitialization) CodecEncodeSelf(e *codec1978.Encoder) {
+func (Item) codecSelferViaCodecgen() {}
+func (x *Item) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -1734,48 +2180,352 @@ func (x *NewUserInitialization) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(2)
+			z.EncWriteArrayStart(15)
 			z.EncWriteArrayElem()
-			r.EncodeString(string(x.Email))
+			yy18 := &x.ID
+			if yyxt19 := z.Extension(yy18); yyxt19 != nil {
+				z.EncExtension(yy18, yyxt19)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy18)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy18[:]), e)
+			}
+			z.EncWriteArrayElem()
+			yy20 := &x.FeedID
+			if yyxt21 := z.Extension(yy20); yyxt21 != nil {
+				z.EncExtension(yy20, yyxt21)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy20)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy20[:]), e)
+			}
+			z.EncWriteArrayElem()
+			yy22 := &x.Owner
+			if yyxt23 := z.Extension(yy22); yyxt23 != nil {
+				z.EncExtension(yy22, yyxt23)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy22)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy22[:]), e)
+			}
 			z.EncWriteArrayElem()
-			yy6 := &x.Signature
-			h.encArray65uint8((*[65]uint8)(yy6), e)
+			r.EncodeString(string(x.Key))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Title))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Link))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Author))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Content))
+			z.EncWriteArrayElem()
+			if x.Enclosures == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceEnclosure(([]Enclosure)(x.Enclosures), e)
+			} // end block: if x.Enclosures slice == nil
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.Published)
+			} else if yyxt30 := z.Extension(x.Published); yyxt30 != nil {
+				z.EncExtension(x.Published, yyxt30)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.Published)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.Published)
+			} else {
+				z.EncFallback(x.Published)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.Updated)
+			} else if yyxt31 := z.Extension(x.Updated); yyxt31 != nil {
+				z.EncExtension(x.Updated, yyxt31)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.Updated)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.Updated)
+			} else {
+				z.EncFallback(x.Updated)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.FetchedAt)
+			} else if yyxt32 := z.Extension(x.FetchedAt); yyxt32 != nil {
+				z.EncExtension(x.FetchedAt, yyxt32)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.FetchedAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.FetchedAt)
+			} else {
+				z.EncFallback(x.FetchedAt)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Read))
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Starred))
+			z.EncWriteArrayElem()
+			if x.Searches == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.Searches), e)
+			} // end block: if x.Searches slice == nil
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(2)
+			z.EncWriteMapStart(15)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`email`)
+				r.EncodeString(`author`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Email))
+				r.EncodeString(string(x.Author))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`signature`)
+				r.EncodeString(`content`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Content))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`enclosures`)
+				z.EncWriteMapElemValue()
+				if x.Enclosures == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceEnclosure(([]Enclosure)(x.Enclosures), e)
+				} // end block: if x.Enclosures slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy39 := &x.FeedID
+				if yyxt40 := z.Extension(yy39); yyxt40 != nil {
+					z.EncExtension(yy39, yyxt40)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy39)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy39[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`fetched_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.FetchedAt)
+				} else if yyxt41 := z.Extension(x.FetchedAt); yyxt41 != nil {
+					z.EncExtension(x.FetchedAt, yyxt41)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.FetchedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.FetchedAt)
+				} else {
+					z.EncFallback(x.FetchedAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy42 := &x.ID
+				if yyxt43 := z.Extension(yy42); yyxt43 != nil {
+					z.EncExtension(yy42, yyxt43)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy42)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy42[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`key`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Key))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`link`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Link))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`owner_id`)
 				z.EncWriteMapElemValue()
-				yy9 := &x.Signature
-				h.encArray65uint8((*[65]uint8)(yy9), e)
+				yy46 := &x.Owner
+				if yyxt47 := z.Extension(yy46); yyxt47 != nil {
+					z.EncExtension(yy46, yyxt47)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy46)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy46[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`published`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Published)
+				} else if yyxt48 := z.Extension(x.Published); yyxt48 != nil {
+					z.EncExtension(x.Published, yyxt48)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Published)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Published)
+				} else {
+					z.EncFallback(x.Published)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`read`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Read))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`searches`)
+				z.EncWriteMapElemValue()
+				if x.Searches == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.Searches), e)
+				} // end block: if x.Searches slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`starred`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Starred))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`title`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Title))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`updated`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Updated)
+				} else if yyxt53 := z.Extension(x.Updated); yyxt53 != nil {
+					z.EncExtension(x.Updated, yyxt53)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Updated)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Updated)
+				} else {
+					z.EncFallback(x.Updated)
+				}
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`email`)
+				r.EncodeString(`id`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Email))
+				yy54 := &x.ID
+				if yyxt55 := z.Extension(yy54); yyxt55 != nil {
+					z.EncExtension(yy54, yyxt55)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy54)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy54[:]), e)
+				}
 				z.EncWriteMapElemKey()
-				r.EncodeString(`signature`)
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy56 := &x.FeedID
+				if yyxt57 := z.Extension(yy56); yyxt57 != nil {
+					z.EncExtension(yy56, yyxt57)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy56)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy56[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`owner_id`)
+				z.EncWriteMapElemValue()
+				yy58 := &x.Owner
+				if yyxt59 := z.Extension(yy58); yyxt59 != nil {
+					z.EncExtension(yy58, yyxt59)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy58)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy58[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`key`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Key))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`title`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Title))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`link`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Link))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`author`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Author))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`content`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Content))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`enclosures`)
+				z.EncWriteMapElemValue()
+				if x.Enclosures == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceEnclosure(([]Enclosure)(x.Enclosures), e)
+				} // end block: if x.Enclosures slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`published`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Published)
+				} else if yyxt66 := z.Extension(x.Published); yyxt66 != nil {
+					z.EncExtension(x.Published, yyxt66)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Published)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Published)
+				} else {
+					z.EncFallback(x.Published)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`updated`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Updated)
+				} else if yyxt67 := z.Extension(x.Updated); yyxt67 != nil {
+					z.EncExtension(x.Updated, yyxt67)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Updated)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Updated)
+				} else {
+					z.EncFallback(x.Updated)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`fetched_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.FetchedAt)
+				} else if yyxt68 := z.Extension(x.FetchedAt); yyxt68 != nil {
+					z.EncExtension(x.FetchedAt, yyxt68)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.FetchedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.FetchedAt)
+				} else {
+					z.EncFallback(x.FetchedAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`read`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Read))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`starred`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Starred))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`searches`)
 				z.EncWriteMapElemValue()
-				yy12 := &x.Signature
-				h.encArray65uint8((*[65]uint8)(yy12), e)
+				if x.Searches == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.Searches), e)
+				} // end block: if x.Searches slice == nil
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *NewUserInitialization) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *Item) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = NewUserInitialization{}
+		*(x) = Item{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -1794,7 +2544,7 @@ func (x *NewUserInitialization) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *NewUserInitialization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *Item) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -1804,160 +2554,277 @@ func (x *NewUserInitialization) codecDecodeSelfFromMap(l int, d *codec1978.Decod
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "email":
-			x.Email = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-		case "signature":
-			h.decArray65uint8((*[65]uint8)(&x.Signature), d)
+		case "id":
+			if yyxt5 := z.Extension(x.ID); yyxt5 != nil {
+				z.DecExtension(&x.ID, yyxt5)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+			}
+		case "feed_id":
+			if yyxt7 := z.Extension(x.FeedID); yyxt7 != nil {
+				z.DecExtension(&x.FeedID, yyxt7)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FeedID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+			}
+		case "owner_id":
+			if yyxt9 := z.Extension(x.Owner); yyxt9 != nil {
+				z.DecExtension(&x.Owner, yyxt9)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Owner)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.Owner[:]), d)
+			}
+		case "key":
+			x.Key = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "title":
+			x.Title = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "link":
+			x.Link = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "author":
+			x.Author = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "content":
+			x.Content = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "enclosures":
+			h.decSliceEnclosure((*[]Enclosure)(&x.Enclosures), d)
+		case "published":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.Published = r.DecodeTime()
+			} else if yyxt18 := z.Extension(x.Published); yyxt18 != nil {
+				z.DecExtension(&x.Published, yyxt18)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.Published)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Published)
+			} else {
+				z.DecFallback(&x.Published, false)
+			}
+		case "updated":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.Updated = r.DecodeTime()
+			} else if yyxt20 := z.Extension(x.Updated); yyxt20 != nil {
+				z.DecExtension(&x.Updated, yyxt20)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.Updated)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Updated)
+			} else {
+				z.DecFallback(&x.Updated, false)
+			}
+		case "fetched_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.FetchedAt = r.DecodeTime()
+			} else if yyxt22 := z.Extension(x.FetchedAt); yyxt22 != nil {
+				z.DecExtension(&x.FetchedAt, yyxt22)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.FetchedAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FetchedAt)
+			} else {
+				z.DecFallback(&x.FetchedAt, false)
+			}
+		case "read":
+			x.Read = (bool)(r.DecodeBool())
+		case "starred":
+			x.Starred = (bool)(r.DecodeBool())
+		case "searches":
+			h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.Searches), d)
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *NewUserInitialization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *Item) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj7 int
-	var yyb7 bool
-	var yyhl7 bool = l >= 0
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	var yyj27 int
+	var yyb27 bool
+	var yyhl27 bool = l >= 0
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Email = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj7++
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	if yyxt29 := z.Extension(x.ID); yyxt29 != nil {
+		z.DecExtension(&x.ID, yyxt29)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	}
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decArray65uint8((*[65]uint8)(&x.Signature), d)
-	yyj7++
-	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj7-1, "")
+	if yyxt31 := z.Extension(x.FeedID); yyxt31 != nil {
+		z.DecExtension(&x.FeedID, yyxt31)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FeedID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
 	}
-}
-
-func (x *NewUserInitialization) IsCodecEmpty() bool {
-	return !(x.Email != "" || x.Signature != [65]uint8{} || false)
-}
-
-func (OrdinaryInitialization) codecSelferViaCodecgen() {}
-func (x *OrdinaryInitialization) CodecEncodeSelf(e *codec1978.Encoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if z.EncBasicHandle().CheckCircularRef {
-		z.EncEncode(x)
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
 		return
 	}
-	if x == nil {
-		r.EncodeNil()
+	z.DecReadArrayElem()
+	if yyxt33 := z.Extension(x.Owner); yyxt33 != nil {
+		z.DecExtension(&x.Owner, yyxt33)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Owner)
 	} else {
-		yy2arr2 := z.EncBasicHandle().StructToArray
-		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
-		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(1)
-			z.EncWriteArrayElem()
-			yy4 := &x.Signature
-			h.encArray65uint8((*[65]uint8)(yy4), e)
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(1)
-			if z.EncBasicHandle().Canonical {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`signature`)
-				z.EncWriteMapElemValue()
-				yy6 := &x.Signature
-				h.encArray65uint8((*[65]uint8)(yy6), e)
-			} else {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`signature`)
-				z.EncWriteMapElemValue()
-				yy8 := &x.Signature
-				h.encArray65uint8((*[65]uint8)(yy8), e)
-			}
-			z.EncWriteMapEnd()
-		}
+		z.F.DecSliceUint8N(([]uint8)(x.Owner[:]), d)
 	}
-}
-
-func (x *OrdinaryInitialization) CodecDecodeSelf(d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	yyct2 := r.ContainerType()
-	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = OrdinaryInitialization{}
-	} else if yyct2 == codecSelferValueTypeMap42 {
-		yyl2 := z.DecReadMapStart()
-		if yyl2 == 0 {
-		} else {
-			x.codecDecodeSelfFromMap(yyl2, d)
-		}
-		z.DecReadMapEnd()
-	} else if yyct2 == codecSelferValueTypeArray42 {
-		yyl2 := z.DecReadArrayStart()
-		if yyl2 != 0 {
-			x.codecDecodeSelfFromArray(yyl2, d)
-		}
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
 		z.DecReadArrayEnd()
-	} else {
-		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+		return
 	}
-}
-
-func (x *OrdinaryInitialization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyhl3 bool = l >= 0
-	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
-		z.DecReadMapElemKey()
-		yys3 := r.DecodeStringAsBytes()
-		z.DecReadMapElemValue()
-		switch string(yys3) {
-		case "signature":
-			h.decArray65uint8((*[65]uint8)(&x.Signature), d)
-		default:
-			z.DecStructFieldNotFound(-1, string(yys3))
-		} // end switch yys3
-	} // end for yyj3
-}
-
-func (x *OrdinaryInitialization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyj6 int
-	var yyb6 bool
-	var yyhl6 bool = l >= 0
-	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
-	if yyb6 {
+	z.DecReadArrayElem()
+	x.Key = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decArray65uint8((*[65]uint8)(&x.Signature), d)
-	yyj6++
-	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+	x.Title = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Link = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Author = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Content = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceEnclosure((*[]Enclosure)(&x.Enclosures), d)
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.Published = r.DecodeTime()
+	} else if yyxt42 := z.Extension(x.Published); yyxt42 != nil {
+		z.DecExtension(&x.Published, yyxt42)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.Published)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Published)
+	} else {
+		z.DecFallback(&x.Published, false)
+	}
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.Updated = r.DecodeTime()
+	} else if yyxt44 := z.Extension(x.Updated); yyxt44 != nil {
+		z.DecExtension(&x.Updated, yyxt44)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.Updated)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Updated)
+	} else {
+		z.DecFallback(&x.Updated, false)
+	}
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.FetchedAt = r.DecodeTime()
+	} else if yyxt46 := z.Extension(x.FetchedAt); yyxt46 != nil {
+		z.DecExtension(&x.FetchedAt, yyxt46)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.FetchedAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FetchedAt)
+	} else {
+		z.DecFallback(&x.FetchedAt, false)
+	}
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Read = (bool)(r.DecodeBool())
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Starred = (bool)(r.DecodeBool())
+	yyj27++
+	yyb27 = !z.DecContainerNext(yyj27, l, yyhl27)
+	if yyb27 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.Searches), d)
+	yyj27++
+	for ; z.DecContainerNext(yyj27, l, yyhl27); yyj27++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj6-1, "")
+		z.DecStructFieldNotFound(yyj27-1, "")
 	}
 }
 
-func (x *OrdinaryInitialization) IsCodecEmpty() bool {
-	return !(x.Signature != [65]uint8{} || false)
+func (x *Item) IsCodecEmpty() bool {
+	return !(x.ID != pkg1_primitive.ObjectID{} || x.FeedID != pkg1_primitive.ObjectID{} || x.Owner != pkg1_primitive.ObjectID{} || x.Key != "" || x.Title != "" || x.Link != "" || x.Author != "" || x.Content != "" || len(x.Enclosures) != 0 || !(x.Published.IsZero()) || !(x.Updated.IsZero()) || !(x.FetchedAt.IsZero()) || bool(x.Read) || bool(x.Starred) || len(x.Searches) != 0 || false)
 }
 
-func (Welcome) codecSelferViaCodecgen() {}
-func (x *Welcome) CodecEncodeSelf(e *codec1978.Encoder) {
+func (Enclosure) codecSelferViaCodecgen() {}
+func (x *Enclosure) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -1974,68 +2841,53 @@ func (x *Welcome) CodecEncodeSelf(e *codec1978.Encoder) {
 		if yyr2 || yy2arr2 {
 			z.EncWriteArrayStart(3)
 			z.EncWriteArrayElem()
-			r.EncodeBool(bool(x.LoggedIn))
+			r.EncodeString(string(x.URL))
 			z.EncWriteArrayElem()
-			r.EncodeString(string(x.Message))
+			r.EncodeString(string(x.Type))
 			z.EncWriteArrayElem()
-			yy8 := &x.User
-			if yyxt9 := z.Extension(yy8); yyxt9 != nil {
-				z.EncExtension(yy8, yyxt9)
-			} else {
-				yy8.CodecEncodeSelf(e)
-			}
+			r.EncodeUint(uint64(x.Length))
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(3)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`logged_in`)
+				r.EncodeString(`length`)
 				z.EncWriteMapElemValue()
-				r.EncodeBool(bool(x.LoggedIn))
+				r.EncodeUint(uint64(x.Length))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`message`)
+				r.EncodeString(`type`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Message))
+				r.EncodeString(string(x.Type))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`user`)
+				r.EncodeString(`url`)
 				z.EncWriteMapElemValue()
-				yy12 := &x.User
-				if yyxt13 := z.Extension(yy12); yyxt13 != nil {
-					z.EncExtension(yy12, yyxt13)
-				} else {
-					yy12.CodecEncodeSelf(e)
-				}
+				r.EncodeString(string(x.URL))
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`logged_in`)
+				r.EncodeString(`url`)
 				z.EncWriteMapElemValue()
-				r.EncodeBool(bool(x.LoggedIn))
+				r.EncodeString(string(x.URL))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`message`)
+				r.EncodeString(`type`)
 				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.Message))
+				r.EncodeString(string(x.Type))
 				z.EncWriteMapElemKey()
-				r.EncodeString(`user`)
+				r.EncodeString(`length`)
 				z.EncWriteMapElemValue()
-				yy16 := &x.User
-				if yyxt17 := z.Extension(yy16); yyxt17 != nil {
-					z.EncExtension(yy16, yyxt17)
-				} else {
-					yy16.CodecEncodeSelf(e)
-				}
+				r.EncodeUint(uint64(x.Length))
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *Welcome) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *Enclosure) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = Welcome{}
+		*(x) = Enclosure{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -2054,7 +2906,7 @@ func (x *Welcome) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *Welcome) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *Enclosure) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -2064,69 +2916,61 @@ func (x *Welcome) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "logged_in":
-			x.LoggedIn = (bool)(r.DecodeBool())
-		case "message":
-			x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-		case "user":
-			if yyxt7 := z.Extension(x.User); yyxt7 != nil {
-				z.DecExtension(&x.User, yyxt7)
-			} else {
-				x.User.CodecDecodeSelf(d)
-			}
+		case "url":
+			x.URL = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "type":
+			x.Type = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "length":
+			x.Length = (uint64)(r.DecodeUint64())
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *Welcome) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *Enclosure) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj8 int
-	var yyb8 bool
-	var yyhl8 bool = l >= 0
-	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
-	if yyb8 {
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.LoggedIn = (bool)(r.DecodeBool())
-	yyj8++
-	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
-	if yyb8 {
+	x.URL = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
-	yyj8++
-	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
-	if yyb8 {
+	x.Type = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt12 := z.Extension(x.User); yyxt12 != nil {
-		z.DecExtension(&x.User, yyxt12)
-	} else {
-		x.User.CodecDecodeSelf(d)
-	}
-	yyj8++
-	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
+	x.Length = (uint64)(r.DecodeUint64())
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj8-1, "")
+		z.DecStructFieldNotFound(yyj7-1, "")
 	}
 }
 
-func (x *Welcome) IsCodecEmpty() bool {
-	return !(bool(x.LoggedIn) || x.Message != "" || !(x.User.IsCodecEmpty()) || false)
+func (x *Enclosure) IsCodecEmpty() bool {
+	return !(x.URL != "" || x.Type != "" || x.Length != 0 || false)
 }
 
-func (ListFeedsRequest) codecSelferViaCodecgen() {}
-func (x *ListFeedsRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+func (Session) codecSelferViaCodecgen() {}
+func (x *Session) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -2141,163 +2985,220 @@ func (x *ListFeedsRequest) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(1)
+			z.EncWriteArrayStart(6)
 			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.Sort))
+			yy9 := &x.ID
+			if yyxt10 := z.Extension(yy9); yyxt10 != nil {
+				z.EncExtension(yy9, yyxt10)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy9)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy9[:]), e)
+			}
+			z.EncWriteArrayElem()
+			yy11 := &x.UserID
+			if yyxt12 := z.Extension(yy11); yyxt12 != nil {
+				z.EncExtension(yy11, yyxt12)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy11)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy11[:]), e)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.CreatedAt)
+			} else if yyxt13 := z.Extension(x.CreatedAt); yyxt13 != nil {
+				z.EncExtension(x.CreatedAt, yyxt13)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.CreatedAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.CreatedAt)
+			} else {
+				z.EncFallback(x.CreatedAt)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.ExpiresAt)
+			} else if yyxt14 := z.Extension(x.ExpiresAt); yyxt14 != nil {
+				z.EncExtension(x.ExpiresAt, yyxt14)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.ExpiresAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.ExpiresAt)
+			} else {
+				z.EncFallback(x.ExpiresAt)
+			}
+			z.EncWriteArrayElem()
+			if x.Scopes == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(x.Scopes, e)
+			} // end block: if x.Scopes slice == nil
+			z.EncWriteArrayElem()
+			yy16 := &x.KeyID
+			if yyxt17 := z.Extension(yy16); yyxt17 != nil {
+				z.EncExtension(yy16, yyxt17)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy16)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy16[:]), e)
+			}
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(1)
+			z.EncWriteMapStart(6)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`sort`)
+				r.EncodeString(`created_at`)
 				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.Sort))
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.CreatedAt)
+				} else if yyxt18 := z.Extension(x.CreatedAt); yyxt18 != nil {
+					z.EncExtension(x.CreatedAt, yyxt18)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.CreatedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.CreatedAt)
+				} else {
+					z.EncFallback(x.CreatedAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`expires_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt19 := z.Extension(x.ExpiresAt); yyxt19 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt19)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy20 := &x.ID
+				if yyxt21 := z.Extension(yy20); yyxt21 != nil {
+					z.EncExtension(yy20, yyxt21)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy20)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy20[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`key_id`)
+				z.EncWriteMapElemValue()
+				yy22 := &x.KeyID
+				if yyxt23 := z.Extension(yy22); yyxt23 != nil {
+					z.EncExtension(yy22, yyxt23)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy22)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy22[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`scopes`)
+				z.EncWriteMapElemValue()
+				if x.Scopes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Scopes, e)
+				} // end block: if x.Scopes slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`user_id`)
+				z.EncWriteMapElemValue()
+				yy25 := &x.UserID
+				if yyxt26 := z.Extension(yy25); yyxt26 != nil {
+					z.EncExtension(yy25, yyxt26)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy25)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy25[:]), e)
+				}
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`sort`)
+				r.EncodeString(`id`)
 				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.Sort))
-			}
-			z.EncWriteMapEnd()
-		}
-	}
-}
-
-func (x *ListFeedsRequest) CodecDecodeSelf(d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	yyct2 := r.ContainerType()
-	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = ListFeedsRequest{}
-	} else if yyct2 == codecSelferValueTypeMap42 {
-		yyl2 := z.DecReadMapStart()
-		if yyl2 == 0 {
-		} else {
-			x.codecDecodeSelfFromMap(yyl2, d)
-		}
-		z.DecReadMapEnd()
-	} else if yyct2 == codecSelferValueTypeArray42 {
-		yyl2 := z.DecReadArrayStart()
-		if yyl2 != 0 {
-			x.codecDecodeSelfFromArray(yyl2, d)
-		}
-		z.DecReadArrayEnd()
-	} else {
-		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
-	}
-}
-
-func (x *ListFeedsRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyhl3 bool = l >= 0
-	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
-		z.DecReadMapElemKey()
-		yys3 := r.DecodeStringAsBytes()
-		z.DecReadMapElemValue()
-		switch string(yys3) {
-		case "sort":
-			x.Sort = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-		default:
-			z.DecStructFieldNotFound(-1, string(yys3))
-		} // end switch yys3
-	} // end for yyj3
-}
-
-func (x *ListFeedsRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyj5 int
-	var yyb5 bool
-	var yyhl5 bool = l >= 0
-	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
-	if yyb5 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	x.Sort = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj5++
-	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj5-1, "")
-	}
-}
-
-func (x *ListFeedsRequest) IsCodecEmpty() bool {
-	return !(x.Sort != 0 || false)
-}
-
-func (ListFeedsResponse) codecSelferViaCodecgen() {}
-func (x *ListFeedsResponse) CodecEncodeSelf(e *codec1978.Encoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if z.EncBasicHandle().CheckCircularRef {
-		z.EncEncode(x)
-		return
-	}
-	if x == nil {
-		r.EncodeNil()
-	} else {
-		yy2arr2 := z.EncBasicHandle().StructToArray
-		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
-		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(2)
-			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.Count))
-			z.EncWriteArrayElem()
-			if x.Feeds == nil {
-				r.EncodeNil()
-			} else {
-				h.encSliceFeed(([]Feed)(x.Feeds), e)
-			} // end block: if x.Feeds slice == nil
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(2)
-			if z.EncBasicHandle().Canonical {
+				yy27 := &x.ID
+				if yyxt28 := z.Extension(yy27); yyxt28 != nil {
+					z.EncExtension(yy27, yyxt28)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy27)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy27[:]), e)
+				}
 				z.EncWriteMapElemKey()
-				r.EncodeString(`count`)
+				r.EncodeString(`user_id`)
 				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.Count))
+				yy29 := &x.UserID
+				if yyxt30 := z.Extension(yy29); yyxt30 != nil {
+					z.EncExtension(yy29, yyxt30)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy29)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy29[:]), e)
+				}
 				z.EncWriteMapElemKey()
-				r.EncodeString(`feeds`)
+				r.EncodeString(`created_at`)
 				z.EncWriteMapElemValue()
-				if x.Feeds == nil {
-					r.EncodeNil()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.CreatedAt)
+				} else if yyxt31 := z.Extension(x.CreatedAt); yyxt31 != nil {
+					z.EncExtension(x.CreatedAt, yyxt31)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.CreatedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.CreatedAt)
 				} else {
-					h.encSliceFeed(([]Feed)(x.Feeds), e)
-				} // end block: if x.Feeds slice == nil
-			} else {
+					z.EncFallback(x.CreatedAt)
+				}
 				z.EncWriteMapElemKey()
-				r.EncodeString(`count`)
+				r.EncodeString(`expires_at`)
 				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.Count))
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt32 := z.Extension(x.ExpiresAt); yyxt32 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt32)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
 				z.EncWriteMapElemKey()
-				r.EncodeString(`feeds`)
+				r.EncodeString(`scopes`)
 				z.EncWriteMapElemValue()
-				if x.Feeds == nil {
+				if x.Scopes == nil {
 					r.EncodeNil()
 				} else {
-					h.encSliceFeed(([]Feed)(x.Feeds), e)
-				} // end block: if x.Feeds slice == nil
+					z.F.EncSliceStringV(x.Scopes, e)
+				} // end block: if x.Scopes slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`key_id`)
+				z.EncWriteMapElemValue()
+				yy34 := &x.KeyID
+				if yyxt35 := z.Extension(yy34); yyxt35 != nil {
+					z.EncExtension(yy34, yyxt35)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy34)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy34[:]), e)
+				}
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *ListFeedsResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *Session) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = ListFeedsResponse{}
+		*(x) = Session{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -2316,7 +3217,7 @@ func (x *ListFeedsResponse) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *ListFeedsResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *Session) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -2326,51 +3227,167 @@ func (x *ListFeedsResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "count":
-			x.Count = (uint64)(r.DecodeUint64())
-		case "feeds":
-			h.decSliceFeed((*[]Feed)(&x.Feeds), d)
+		case "id":
+			if yyxt5 := z.Extension(x.ID); yyxt5 != nil {
+				z.DecExtension(&x.ID, yyxt5)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+			}
+		case "user_id":
+			if yyxt7 := z.Extension(x.UserID); yyxt7 != nil {
+				z.DecExtension(&x.UserID, yyxt7)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.UserID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.UserID[:]), d)
+			}
+		case "created_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.CreatedAt = r.DecodeTime()
+			} else if yyxt9 := z.Extension(x.CreatedAt); yyxt9 != nil {
+				z.DecExtension(&x.CreatedAt, yyxt9)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.CreatedAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.CreatedAt)
+			} else {
+				z.DecFallback(&x.CreatedAt, false)
+			}
+		case "expires_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.ExpiresAt = r.DecodeTime()
+			} else if yyxt11 := z.Extension(x.ExpiresAt); yyxt11 != nil {
+				z.DecExtension(&x.ExpiresAt, yyxt11)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.ExpiresAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ExpiresAt)
+			} else {
+				z.DecFallback(&x.ExpiresAt, false)
+			}
+		case "scopes":
+			z.F.DecSliceStringX(&x.Scopes, d)
+		case "key_id":
+			if yyxt15 := z.Extension(x.KeyID); yyxt15 != nil {
+				z.DecExtension(&x.KeyID, yyxt15)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.KeyID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.KeyID[:]), d)
+			}
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *ListFeedsResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *Session) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj7 int
-	var yyb7 bool
-	var yyhl7 bool = l >= 0
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	var yyj16 int
+	var yyb16 bool
+	var yyhl16 bool = l >= 0
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Count = (uint64)(r.DecodeUint64())
-	yyj7++
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	if yyxt18 := z.Extension(x.ID); yyxt18 != nil {
+		z.DecExtension(&x.ID, yyxt18)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	}
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSliceFeed((*[]Feed)(&x.Feeds), d)
-	yyj7++
-	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+	if yyxt20 := z.Extension(x.UserID); yyxt20 != nil {
+		z.DecExtension(&x.UserID, yyxt20)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.UserID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.UserID[:]), d)
+	}
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.CreatedAt = r.DecodeTime()
+	} else if yyxt22 := z.Extension(x.CreatedAt); yyxt22 != nil {
+		z.DecExtension(&x.CreatedAt, yyxt22)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.CreatedAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.CreatedAt)
+	} else {
+		z.DecFallback(&x.CreatedAt, false)
+	}
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.ExpiresAt = r.DecodeTime()
+	} else if yyxt24 := z.Extension(x.ExpiresAt); yyxt24 != nil {
+		z.DecExtension(&x.ExpiresAt, yyxt24)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.ExpiresAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ExpiresAt)
+	} else {
+		z.DecFallback(&x.ExpiresAt, false)
+	}
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecSliceStringX(&x.Scopes, d)
+	yyj16++
+	yyb16 = !z.DecContainerNext(yyj16, l, yyhl16)
+	if yyb16 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt28 := z.Extension(x.KeyID); yyxt28 != nil {
+		z.DecExtension(&x.KeyID, yyxt28)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.KeyID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.KeyID[:]), d)
+	}
+	yyj16++
+	for ; z.DecContainerNext(yyj16, l, yyhl16); yyj16++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj7-1, "")
+		z.DecStructFieldNotFound(yyj16-1, "")
 	}
 }
 
-func (x *ListFeedsResponse) IsCodecEmpty() bool {
-	return !(x.Count != 0 || len(x.Feeds) != 0 || false)
+func (x *Session) IsCodecEmpty() bool {
+	return !(x.ID != pkg1_primitive.ObjectID{} || x.UserID != pkg1_primitive.ObjectID{} || !(x.CreatedAt.IsZero()) || !(x.ExpiresAt.IsZero()) || len(x.Scopes) != 0 || x.KeyID != pkg1_primitive.ObjectID{} || false)
 }
 
-func (DeleteFeedRequest) codecSelferViaCodecgen() {}
-func (x *DeleteFeedRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+func (APIKey) codecSelferViaCodecgen() {}
+func (x *APIKey) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -2385,42 +3402,235 @@ func (x *DeleteFeedRequest) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(1)
+			z.EncWriteArrayStart(8)
 			z.EncWriteArrayElem()
-			yy4 := &x.ID
-			if yyxt5 := z.Extension(yy4); yyxt5 != nil {
-				z.EncExtension(yy4, yyxt5)
+			yy11 := &x.ID
+			if yyxt12 := z.Extension(yy11); yyxt12 != nil {
+				z.EncExtension(yy11, yyxt12)
 			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(*yy4)
+				z.EncJSONMarshal(*yy11)
 			} else {
-				z.F.EncSliceUint8V(([]uint8)(yy4[:]), e)
+				z.F.EncSliceUint8V(([]uint8)(yy11[:]), e)
 			}
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(1)
-			if z.EncBasicHandle().Canonical {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
-				z.EncWriteMapElemValue()
-				yy6 := &x.ID
-				if yyxt7 := z.Extension(yy6); yyxt7 != nil {
-					z.EncExtension(yy6, yyxt7)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy6)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy6[:]), e)
-				}
+			z.EncWriteArrayElem()
+			yy13 := &x.UserID
+			if yyxt14 := z.Extension(yy13); yyxt14 != nil {
+				z.EncExtension(yy13, yyxt14)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy13)
 			} else {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
-				z.EncWriteMapElemValue()
-				yy8 := &x.ID
-				if yyxt9 := z.Extension(yy8); yyxt9 != nil {
-					z.EncExtension(yy8, yyxt9)
+				z.F.EncSliceUint8V(([]uint8)(yy13[:]), e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Name))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Prefix))
+			z.EncWriteArrayElem()
+			if x.Scopes == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(x.Scopes, e)
+			} // end block: if x.Scopes slice == nil
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.CreatedAt)
+			} else if yyxt18 := z.Extension(x.CreatedAt); yyxt18 != nil {
+				z.EncExtension(x.CreatedAt, yyxt18)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.CreatedAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.CreatedAt)
+			} else {
+				z.EncFallback(x.CreatedAt)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.ExpiresAt)
+			} else if yyxt19 := z.Extension(x.ExpiresAt); yyxt19 != nil {
+				z.EncExtension(x.ExpiresAt, yyxt19)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.ExpiresAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.ExpiresAt)
+			} else {
+				z.EncFallback(x.ExpiresAt)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.LastUsed)
+			} else if yyxt20 := z.Extension(x.LastUsed); yyxt20 != nil {
+				z.EncExtension(x.LastUsed, yyxt20)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.LastUsed)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.LastUsed)
+			} else {
+				z.EncFallback(x.LastUsed)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(8)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`created_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.CreatedAt)
+				} else if yyxt21 := z.Extension(x.CreatedAt); yyxt21 != nil {
+					z.EncExtension(x.CreatedAt, yyxt21)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.CreatedAt)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy8)
+					z.EncJSONMarshal(x.CreatedAt)
 				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy8[:]), e)
+					z.EncFallback(x.CreatedAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`expires_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt22 := z.Extension(x.ExpiresAt); yyxt22 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt22)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy23 := &x.ID
+				if yyxt24 := z.Extension(yy23); yyxt24 != nil {
+					z.EncExtension(yy23, yyxt24)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy23)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy23[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`last_used`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.LastUsed)
+				} else if yyxt25 := z.Extension(x.LastUsed); yyxt25 != nil {
+					z.EncExtension(x.LastUsed, yyxt25)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.LastUsed)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.LastUsed)
+				} else {
+					z.EncFallback(x.LastUsed)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Name))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`prefix`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Prefix))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`scopes`)
+				z.EncWriteMapElemValue()
+				if x.Scopes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Scopes, e)
+				} // end block: if x.Scopes slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`user_id`)
+				z.EncWriteMapElemValue()
+				yy29 := &x.UserID
+				if yyxt30 := z.Extension(yy29); yyxt30 != nil {
+					z.EncExtension(yy29, yyxt30)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy29)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy29[:]), e)
+				}
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy31 := &x.ID
+				if yyxt32 := z.Extension(yy31); yyxt32 != nil {
+					z.EncExtension(yy31, yyxt32)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy31)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy31[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`user_id`)
+				z.EncWriteMapElemValue()
+				yy33 := &x.UserID
+				if yyxt34 := z.Extension(yy33); yyxt34 != nil {
+					z.EncExtension(yy33, yyxt34)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy33)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy33[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Name))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`prefix`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Prefix))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`scopes`)
+				z.EncWriteMapElemValue()
+				if x.Scopes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Scopes, e)
+				} // end block: if x.Scopes slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`created_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.CreatedAt)
+				} else if yyxt38 := z.Extension(x.CreatedAt); yyxt38 != nil {
+					z.EncExtension(x.CreatedAt, yyxt38)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.CreatedAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.CreatedAt)
+				} else {
+					z.EncFallback(x.CreatedAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`expires_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt39 := z.Extension(x.ExpiresAt); yyxt39 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt39)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`last_used`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.LastUsed)
+				} else if yyxt40 := z.Extension(x.LastUsed); yyxt40 != nil {
+					z.EncExtension(x.LastUsed, yyxt40)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.LastUsed)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.LastUsed)
+				} else {
+					z.EncFallback(x.LastUsed)
 				}
 			}
 			z.EncWriteMapEnd()
@@ -2428,13 +3638,13 @@ func (x *DeleteFeedRequest) CodecEncodeSelf(e *codec1978.Encoder) {
 	}
 }
 
-func (x *DeleteFeedRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *APIKey) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = DeleteFeedRequest{}
+		*(x) = APIKey{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -2453,7 +3663,7 @@ func (x *DeleteFeedRequest) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *DeleteFeedRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *APIKey) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -2471,151 +3681,187 @@ func (x *DeleteFeedRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 			} else {
 				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
 			}
+		case "user_id":
+			if yyxt7 := z.Extension(x.UserID); yyxt7 != nil {
+				z.DecExtension(&x.UserID, yyxt7)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.UserID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.UserID[:]), d)
+			}
+		case "name":
+			x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "prefix":
+			x.Prefix = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "scopes":
+			z.F.DecSliceStringX(&x.Scopes, d)
+		case "created_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.CreatedAt = r.DecodeTime()
+			} else if yyxt13 := z.Extension(x.CreatedAt); yyxt13 != nil {
+				z.DecExtension(&x.CreatedAt, yyxt13)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.CreatedAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.CreatedAt)
+			} else {
+				z.DecFallback(&x.CreatedAt, false)
+			}
+		case "expires_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.ExpiresAt = r.DecodeTime()
+			} else if yyxt15 := z.Extension(x.ExpiresAt); yyxt15 != nil {
+				z.DecExtension(&x.ExpiresAt, yyxt15)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.ExpiresAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ExpiresAt)
+			} else {
+				z.DecFallback(&x.ExpiresAt, false)
+			}
+		case "last_used":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.LastUsed = r.DecodeTime()
+			} else if yyxt17 := z.Extension(x.LastUsed); yyxt17 != nil {
+				z.DecExtension(&x.LastUsed, yyxt17)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.LastUsed)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.LastUsed)
+			} else {
+				z.DecFallback(&x.LastUsed, false)
+			}
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *DeleteFeedRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *APIKey) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj6 int
-	var yyb6 bool
-	var yyhl6 bool = l >= 0
-	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
-	if yyb6 {
+	var yyj18 int
+	var yyb18 bool
+	var yyhl18 bool = l >= 0
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt8 := z.Extension(x.ID); yyxt8 != nil {
-		z.DecExtension(&x.ID, yyxt8)
+	if yyxt20 := z.Extension(x.ID); yyxt20 != nil {
+		z.DecExtension(&x.ID, yyxt20)
 	} else if !z.DecBinary() && z.IsJSONHandle() {
 		z.DecJSONUnmarshal(&x.ID)
 	} else {
 		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
 	}
-	yyj6++
-	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj6-1, "")
-	}
-}
-
-func (x *DeleteFeedRequest) IsCodecEmpty() bool {
-	return !(x.ID != pkg1_primitive.ObjectID{} || false)
-}
-
-func (DeleteFeedResponse) codecSelferViaCodecgen() {}
-func (x *DeleteFeedResponse) CodecEncodeSelf(e *codec1978.Encoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if z.EncBasicHandle().CheckCircularRef {
-		z.EncEncode(x)
+	yyj18++
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
+		z.DecReadArrayEnd()
 		return
 	}
-	if x == nil {
-		r.EncodeNil()
+	z.DecReadArrayElem()
+	if yyxt22 := z.Extension(x.UserID); yyxt22 != nil {
+		z.DecExtension(&x.UserID, yyxt22)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.UserID)
 	} else {
-		yy2arr2 := z.EncBasicHandle().StructToArray
-		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
-		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(1)
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.DeletedCount))
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(1)
-			if z.EncBasicHandle().Canonical {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`deleted_count`)
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.DeletedCount))
-			} else {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`deleted_count`)
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.DeletedCount))
-			}
-			z.EncWriteMapEnd()
-		}
+		z.F.DecSliceUint8N(([]uint8)(x.UserID[:]), d)
 	}
-}
-
-func (x *DeleteFeedResponse) CodecDecodeSelf(d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	yyct2 := r.ContainerType()
-	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = DeleteFeedResponse{}
-	} else if yyct2 == codecSelferValueTypeMap42 {
-		yyl2 := z.DecReadMapStart()
-		if yyl2 == 0 {
-		} else {
-			x.codecDecodeSelfFromMap(yyl2, d)
-		}
-		z.DecReadMapEnd()
-	} else if yyct2 == codecSelferValueTypeArray42 {
-		yyl2 := z.DecReadArrayStart()
-		if yyl2 != 0 {
-			x.codecDecodeSelfFromArray(yyl2, d)
-		}
+	yyj18++
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
 		z.DecReadArrayEnd()
-	} else {
-		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+		return
 	}
-}
-
-func (x *DeleteFeedResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyhl3 bool = l >= 0
-	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
-		z.DecReadMapElemKey()
-		yys3 := r.DecodeStringAsBytes()
-		z.DecReadMapElemValue()
-		switch string(yys3) {
-		case "deleted_count":
-			x.DeletedCount = (int64)(r.DecodeInt64())
-		default:
-			z.DecStructFieldNotFound(-1, string(yys3))
-		} // end switch yys3
-	} // end for yyj3
-}
-
-func (x *DeleteFeedResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
-	var h codecSelfer42
-	z, r := codec1978.GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyj5 int
-	var yyb5 bool
-	var yyhl5 bool = l >= 0
-	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
-	if yyb5 {
+	z.DecReadArrayElem()
+	x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj18++
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.DeletedCount = (int64)(r.DecodeInt64())
-	yyj5++
-	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
+	x.Prefix = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj18++
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecSliceStringX(&x.Scopes, d)
+	yyj18++
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.CreatedAt = r.DecodeTime()
+	} else if yyxt28 := z.Extension(x.CreatedAt); yyxt28 != nil {
+		z.DecExtension(&x.CreatedAt, yyxt28)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.CreatedAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.CreatedAt)
+	} else {
+		z.DecFallback(&x.CreatedAt, false)
+	}
+	yyj18++
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.ExpiresAt = r.DecodeTime()
+	} else if yyxt30 := z.Extension(x.ExpiresAt); yyxt30 != nil {
+		z.DecExtension(&x.ExpiresAt, yyxt30)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.ExpiresAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ExpiresAt)
+	} else {
+		z.DecFallback(&x.ExpiresAt, false)
+	}
+	yyj18++
+	yyb18 = !z.DecContainerNext(yyj18, l, yyhl18)
+	if yyb18 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.LastUsed = r.DecodeTime()
+	} else if yyxt32 := z.Extension(x.LastUsed); yyxt32 != nil {
+		z.DecExtension(&x.LastUsed, yyxt32)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.LastUsed)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.LastUsed)
+	} else {
+		z.DecFallback(&x.LastUsed, false)
+	}
+	yyj18++
+	for ; z.DecContainerNext(yyj18, l, yyhl18); yyj18++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj5-1, "")
+		z.DecStructFieldNotFound(yyj18-1, "")
 	}
 }
 
-func (x *DeleteFeedResponse) IsCodecEmpty() bool {
-	return !(x.DeletedCount != 0 || false)
+func (x *APIKey) IsCodecEmpty() bool {
+	return !(x.ID != pkg1_primitive.ObjectID{} || x.UserID != pkg1_primitive.ObjectID{} || x.Name != "" || x.Prefix != "" || len(x.Scopes) != 0 || !(x.CreatedAt.IsZero()) || !(x.ExpiresAt.IsZero()) || !(x.LastUsed.IsZero()) || false)
 }
 
-func (GenericIDResponse) codecSelferViaCodecgen() {}
-func (x *GenericIDResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+func (ErrorMessage) codecSelferViaCodecgen() {}
+func (x *ErrorMessage) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -2628,54 +3874,130 @@ func (x *GenericIDResponse) CodecEncodeSelf(e *codec1978.Encoder) {
 	} else {
 		yy2arr2 := z.EncBasicHandle().StructToArray
 		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
+		const yyr2 bool = true // struct tag has 'toArray'
+		var yyq2 = [5]bool{    // should field at this index be written?
+			x.Code != 0,           // Code
+			x.Message != "",       // Message
+			x.Field != "",         // Field
+			x.CorrelationID != "", // CorrelationID
+			x.RetryAfter != 0,     // RetryAfter
+		}
+		_ = yyq2
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(2)
+			z.EncWriteArrayStart(5)
 			z.EncWriteArrayElem()
-			r.EncodeBool(bool(x.OK))
+			if yyq2[0] {
+				if yyxt8 := z.Extension(x.Code); yyxt8 != nil {
+					z.EncExtension(x.Code, yyxt8)
+				} else {
+					r.EncodeUint(uint64(x.Code))
+				}
+			} else {
+				r.EncodeUint(0)
+			}
 			z.EncWriteArrayElem()
-			yy6 := &x.ID
-			if yyxt7 := z.Extension(yy6); yyxt7 != nil {
-				z.EncExtension(yy6, yyxt7)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(*yy6)
+			if yyq2[1] {
+				r.EncodeString(string(x.Message))
 			} else {
-				z.F.EncSliceUint8V(([]uint8)(yy6[:]), e)
+				r.EncodeString("")
+			}
+			z.EncWriteArrayElem()
+			if yyq2[2] {
+				r.EncodeString(string(x.Field))
+			} else {
+				r.EncodeString("")
+			}
+			z.EncWriteArrayElem()
+			if yyq2[3] {
+				r.EncodeString(string(x.CorrelationID))
+			} else {
+				r.EncodeString("")
+			}
+			z.EncWriteArrayElem()
+			if yyq2[4] {
+				r.EncodeUint(uint64(x.RetryAfter))
+			} else {
+				r.EncodeUint(0)
 			}
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(2)
+			var yynn2 int
+			for _, b := range yyq2 {
+				if b {
+					yynn2++
+				}
+			}
+			z.EncWriteMapStart(yynn2)
+			yynn2 = 0
 			if z.EncBasicHandle().Canonical {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
-				z.EncWriteMapElemValue()
-				yy8 := &x.ID
-				if yyxt9 := z.Extension(yy8); yyxt9 != nil {
-					z.EncExtension(yy8, yyxt9)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy8)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy8[:]), e)
+				if yyq2[0] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`Code`)
+					z.EncWriteMapElemValue()
+					if yyxt13 := z.Extension(x.Code); yyxt13 != nil {
+						z.EncExtension(x.Code, yyxt13)
+					} else {
+						r.EncodeUint(uint64(x.Code))
+					}
+				}
+				if yyq2[3] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`CorrelationID`)
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.CorrelationID))
+				}
+				if yyq2[2] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`Field`)
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.Field))
+				}
+				if yyq2[1] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`Message`)
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.Message))
+				}
+				if yyq2[4] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`RetryAfter`)
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.RetryAfter))
 				}
-				z.EncWriteMapElemKey()
-				r.EncodeString(`ok`)
-				z.EncWriteMapElemValue()
-				r.EncodeBool(bool(x.OK))
 			} else {
-				z.EncWriteMapElemKey()
-				r.EncodeString(`ok`)
-				z.EncWriteMapElemValue()
-				r.EncodeBool(bool(x.OK))
-				z.EncWriteMapElemKey()
-				r.EncodeString(`id`)
-				z.EncWriteMapElemValue()
-				yy12 := &x.ID
-				if yyxt13 := z.Extension(yy12); yyxt13 != nil {
-					z.EncExtension(yy12, yyxt13)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(*yy12)
-				} else {
-					z.F.EncSliceUint8V(([]uint8)(yy12[:]), e)
+				if yyq2[0] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`Code`)
+					z.EncWriteMapElemValue()
+					if yyxt18 := z.Extension(x.Code); yyxt18 != nil {
+						z.EncExtension(x.Code, yyxt18)
+					} else {
+						r.EncodeUint(uint64(x.Code))
+					}
+				}
+				if yyq2[1] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`Message`)
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.Message))
+				}
+				if yyq2[2] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`Field`)
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.Field))
+				}
+				if yyq2[3] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`CorrelationID`)
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.CorrelationID))
+				}
+				if yyq2[4] {
+					z.EncWriteMapElemKey()
+					r.EncodeString(`RetryAfter`)
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.RetryAfter))
 				}
 			}
 			z.EncWriteMapEnd()
@@ -2683,13 +4005,13 @@ func (x *GenericIDResponse) CodecEncodeSelf(e *codec1978.Encoder) {
 	}
 }
 
-func (x *GenericIDResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *ErrorMessage) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = GenericIDResponse{}
+		*(x) = ErrorMessage{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -2708,7 +4030,7 @@ func (x *GenericIDResponse) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *GenericIDResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *ErrorMessage) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -2718,63 +4040,89 @@ func (x *GenericIDResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "ok":
-			x.OK = (bool)(r.DecodeBool())
-		case "id":
-			if yyxt6 := z.Extension(x.ID); yyxt6 != nil {
-				z.DecExtension(&x.ID, yyxt6)
-			} else if !z.DecBinary() && z.IsJSONHandle() {
-				z.DecJSONUnmarshal(&x.ID)
+		case "Code":
+			if yyxt5 := z.Extension(x.Code); yyxt5 != nil {
+				z.DecExtension(&x.Code, yyxt5)
 			} else {
-				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+				x.Code = (ErrorCode)(r.DecodeUint64())
 			}
+		case "Message":
+			x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "Field":
+			x.Field = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "CorrelationID":
+			x.CorrelationID = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "RetryAfter":
+			x.RetryAfter = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *GenericIDResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *ErrorMessage) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj7 int
-	var yyb7 bool
-	var yyhl7 bool = l >= 0
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	var yyj10 int
+	var yyb10 bool
+	var yyhl10 bool = l >= 0
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.OK = (bool)(r.DecodeBool())
-	yyj7++
-	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
-	if yyb7 {
+	if yyxt12 := z.Extension(x.Code); yyxt12 != nil {
+		z.DecExtension(&x.Code, yyxt12)
+	} else {
+		x.Code = (ErrorCode)(r.DecodeUint64())
+	}
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt10 := z.Extension(x.ID); yyxt10 != nil {
-		z.DecExtension(&x.ID, yyxt10)
-	} else if !z.DecBinary() && z.IsJSONHandle() {
-		z.DecJSONUnmarshal(&x.ID)
-	} else {
-		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
 	}
-	yyj7++
-	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj7-1, "")
+	z.DecReadArrayElem()
+	x.Field = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.CorrelationID = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.RetryAfter = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj10++
+	for ; z.DecContainerNext(yyj10, l, yyhl10); yyj10++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj10-1, "")
 	}
 }
 
-func (x *GenericIDResponse) IsCodecEmpty() bool {
-	return !(bool(x.OK) || x.ID != pkg1_primitive.ObjectID{} || false)
-}
-
-func (UpdatedFeedResponse) codecSelferViaCodecgen() {}
-func (x *UpdatedFeedResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+func (x *ErrorMessage) IsCodecEmpty() bool {
+	return !(x.Code != 0 || x.Message != "" || x.Field != "" || x.CorrelationID != "" || x.RetryAfter != 0 || false)
+}
+
+func (InitializationRequest) codecSelferViaCodecgen() {}
+func (x *InitializationRequest) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -2789,35 +4137,90 @@ func (x *UpdatedFeedResponse) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(1)
+			z.EncWriteArrayStart(5)
 			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.ModifiedCount))
+			yy8 := &x.Address
+			h.encArray20uint8((*[20]uint8)(yy8), e)
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Locale))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.APIKey))
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.ProtocolVersion))
+			z.EncWriteArrayElem()
+			if x.Capabilities == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(x.Capabilities, e)
+			} // end block: if x.Capabilities slice == nil
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(1)
+			z.EncWriteMapStart(5)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`modified_count`)
+				r.EncodeString(`address`)
 				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.ModifiedCount))
+				yy14 := &x.Address
+				h.encArray20uint8((*[20]uint8)(yy14), e)
+				z.EncWriteMapElemKey()
+				r.EncodeString(`api_key`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.APIKey))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`capabilities`)
+				z.EncWriteMapElemValue()
+				if x.Capabilities == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Capabilities, e)
+				} // end block: if x.Capabilities slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`locale`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Locale))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`protocol_version`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ProtocolVersion))
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`modified_count`)
+				r.EncodeString(`address`)
 				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.ModifiedCount))
+				yy20 := &x.Address
+				h.encArray20uint8((*[20]uint8)(yy20), e)
+				z.EncWriteMapElemKey()
+				r.EncodeString(`locale`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Locale))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`api_key`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.APIKey))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`protocol_version`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ProtocolVersion))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`capabilities`)
+				z.EncWriteMapElemValue()
+				if x.Capabilities == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Capabilities, e)
+				} // end block: if x.Capabilities slice == nil
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *UpdatedFeedResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *InitializationRequest) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = UpdatedFeedResponse{}
+		*(x) = InitializationRequest{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -2836,7 +4239,7 @@ func (x *UpdatedFeedResponse) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *UpdatedFeedResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *InitializationRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -2846,41 +4249,81 @@ func (x *UpdatedFeedResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "modified_count":
-			x.ModifiedCount = (uint64)(r.DecodeUint64())
+		case "address":
+			h.decArray20uint8((*[20]uint8)(&x.Address), d)
+		case "locale":
+			x.Locale = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "api_key":
+			x.APIKey = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "protocol_version":
+			x.ProtocolVersion = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "capabilities":
+			z.F.DecSliceStringX(&x.Capabilities, d)
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *UpdatedFeedResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *InitializationRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj5 int
-	var yyb5 bool
-	var yyhl5 bool = l >= 0
-	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
-	if yyb5 {
+	var yyj11 int
+	var yyb11 bool
+	var yyhl11 bool = l >= 0
+	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
+	if yyb11 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.ModifiedCount = (uint64)(r.DecodeUint64())
-	yyj5++
-	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
+	h.decArray20uint8((*[20]uint8)(&x.Address), d)
+	yyj11++
+	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
+	if yyb11 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Locale = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj11++
+	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
+	if yyb11 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.APIKey = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj11++
+	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
+	if yyb11 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.ProtocolVersion = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj11++
+	yyb11 = !z.DecContainerNext(yyj11, l, yyhl11)
+	if yyb11 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecSliceStringX(&x.Capabilities, d)
+	yyj11++
+	for ; z.DecContainerNext(yyj11, l, yyhl11); yyj11++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj5-1, "")
+		z.DecStructFieldNotFound(yyj11-1, "")
 	}
 }
 
-func (x *UpdatedFeedResponse) IsCodecEmpty() bool {
-	return !(x.ModifiedCount != 0 || false)
+func (x *InitializationRequest) IsCodecEmpty() bool {
+	return !(x.Address != [20]uint8{} || x.Locale != "" || x.APIKey != "" || x.ProtocolVersion != 0 || len(x.Capabilities) != 0 || false)
 }
 
-func (VerifyEmailRequest) codecSelferViaCodecgen() {}
-func (x *VerifyEmailRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+func (InitializationResponse) codecSelferViaCodecgen() {}
+func (x *InitializationResponse) CodecEncodeSelf(e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -2895,38 +4338,89 @@ func (x *VerifyEmailRequest) CodecEncodeSelf(e *codec1978.Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(1)
+			z.EncWriteArrayStart(4)
 			z.EncWriteArrayElem()
-			yy4 := &x.Token
-			h.encArray32uint8((*[32]uint8)(yy4), e)
+			r.EncodeBool(bool(x.UserFound))
+			z.EncWriteArrayElem()
+			if x.Challenge == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Challenge))
+			} // end block: if x.Challenge slice == nil
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.ProtocolVersion))
+			z.EncWriteArrayElem()
+			if x.Capabilities == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(x.Capabilities, e)
+			} // end block: if x.Capabilities slice == nil
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(1)
+			z.EncWriteMapStart(4)
 			if z.EncBasicHandle().Canonical {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`token`)
+				r.EncodeString(`capabilities`)
 				z.EncWriteMapElemValue()
-				yy6 := &x.Token
-				h.encArray32uint8((*[32]uint8)(yy6), e)
+				if x.Capabilities == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Capabilities, e)
+				} // end block: if x.Capabilities slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`challenge`)
+				z.EncWriteMapElemValue()
+				if x.Challenge == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Challenge))
+				} // end block: if x.Challenge slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`protocol_version`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ProtocolVersion))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`user_found`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.UserFound))
 			} else {
 				z.EncWriteMapElemKey()
-				r.EncodeString(`token`)
+				r.EncodeString(`user_found`)
 				z.EncWriteMapElemValue()
-				yy8 := &x.Token
-				h.encArray32uint8((*[32]uint8)(yy8), e)
+				r.EncodeBool(bool(x.UserFound))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`challenge`)
+				z.EncWriteMapElemValue()
+				if x.Challenge == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Challenge))
+				} // end block: if x.Challenge slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`protocol_version`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ProtocolVersion))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`capabilities`)
+				z.EncWriteMapElemValue()
+				if x.Capabilities == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Capabilities, e)
+				} // end block: if x.Capabilities slice == nil
 			}
 			z.EncWriteMapEnd()
 		}
 	}
 }
 
-func (x *VerifyEmailRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+func (x *InitializationResponse) CodecDecodeSelf(d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	yyct2 := r.ContainerType()
 	if yyct2 == codecSelferValueTypeNil42 {
-		*(x) = VerifyEmailRequest{}
+		*(x) = InitializationResponse{}
 	} else if yyct2 == codecSelferValueTypeMap42 {
 		yyl2 := z.DecReadMapStart()
 		if yyl2 == 0 {
@@ -2945,7 +4439,7 @@ func (x *VerifyEmailRequest) CodecDecodeSelf(d *codec1978.Decoder) {
 	}
 }
 
-func (x *VerifyEmailRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+func (x *InitializationResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -2955,40 +4449,5991 @@ func (x *VerifyEmailRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder)
 		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
 		switch string(yys3) {
-		case "token":
-			h.decArray32uint8((*[32]uint8)(&x.Token), d)
+		case "user_found":
+			x.UserFound = (bool)(r.DecodeBool())
+		case "challenge":
+			x.Challenge = z.DecodeBytesInto(([]byte)(x.Challenge))
+		case "protocol_version":
+			x.ProtocolVersion = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "capabilities":
+			z.F.DecSliceStringX(&x.Capabilities, d)
 		default:
 			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
 
-func (x *VerifyEmailRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+func (x *InitializationResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj6 int
-	var yyb6 bool
-	var yyhl6 bool = l >= 0
-	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
-	if yyb6 {
+	var yyj10 int
+	var yyb10 bool
+	var yyhl10 bool = l >= 0
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.UserFound = (bool)(r.DecodeBool())
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Challenge = z.DecodeBytesInto(([]byte)(x.Challenge))
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
 		z.DecReadArrayEnd()
 		return
 	}
-	z.DecReadArrayElem()
-	h.decArray32uint8((*[32]uint8)(&x.Token), d)
-	yyj6++
-	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj6-1, "")
+	z.DecReadArrayElem()
+	x.ProtocolVersion = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecSliceStringX(&x.Capabilities, d)
+	yyj10++
+	for ; z.DecContainerNext(yyj10, l, yyhl10); yyj10++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj10-1, "")
+	}
+}
+
+func (x *InitializationResponse) IsCodecEmpty() bool {
+	return !(bool(x.UserFound) || len(x.Challenge) != 0 || x.ProtocolVersion != 0 || len(x.Capabilities) != 0 || false)
+}
+
+func (NewUserInitialization) codecSelferViaCodecgen() {}
+func (x *NewUserInitialization) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Email))
+			z.EncWriteArrayElem()
+			if x.Signature == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Signature))
+			} // end block: if x.Signature slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`email`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Email))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`signature`)
+				z.EncWriteMapElemValue()
+				if x.Signature == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Signature))
+				} // end block: if x.Signature slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`email`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Email))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`signature`)
+				z.EncWriteMapElemValue()
+				if x.Signature == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Signature))
+				} // end block: if x.Signature slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *NewUserInitialization) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = NewUserInitialization{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *NewUserInitialization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "email":
+			x.Email = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "signature":
+			x.Signature = z.DecodeBytesInto(([]byte)(x.Signature))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *NewUserInitialization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Email = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Signature = z.DecodeBytesInto(([]byte)(x.Signature))
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *NewUserInitialization) IsCodecEmpty() bool {
+	return !(x.Email != "" || len(x.Signature) != 0 || false)
+}
+
+func (OrdinaryInitialization) codecSelferViaCodecgen() {}
+func (x *OrdinaryInitialization) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			if x.Signature == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Signature))
+			} // end block: if x.Signature slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`signature`)
+				z.EncWriteMapElemValue()
+				if x.Signature == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Signature))
+				} // end block: if x.Signature slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`signature`)
+				z.EncWriteMapElemValue()
+				if x.Signature == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Signature))
+				} // end block: if x.Signature slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *OrdinaryInitialization) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = OrdinaryInitialization{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *OrdinaryInitialization) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "signature":
+			x.Signature = z.DecodeBytesInto(([]byte)(x.Signature))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *OrdinaryInitialization) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Signature = z.DecodeBytesInto(([]byte)(x.Signature))
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+}
+
+func (x *OrdinaryInitialization) IsCodecEmpty() bool {
+	return !(len(x.Signature) != 0 || false)
+}
+
+func (Welcome) codecSelferViaCodecgen() {}
+func (x *Welcome) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(7)
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.LoggedIn))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Message))
+			z.EncWriteArrayElem()
+			yy12 := &x.User
+			if yyxt13 := z.Extension(yy12); yyxt13 != nil {
+				z.EncExtension(yy12, yyxt13)
+			} else {
+				yy12.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayElem()
+			yy14 := &x.Quota
+			if yyxt15 := z.Extension(yy14); yyxt15 != nil {
+				z.EncExtension(yy14, yyxt15)
+			} else {
+				yy14.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayElem()
+			yy16 := &x.Usage
+			if yyxt17 := z.Extension(yy16); yyxt17 != nil {
+				z.EncExtension(yy16, yyxt17)
+			} else {
+				yy16.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.ProtocolVersion))
+			z.EncWriteArrayElem()
+			if x.Capabilities == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(x.Capabilities, e)
+			} // end block: if x.Capabilities slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(7)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`capabilities`)
+				z.EncWriteMapElemValue()
+				if x.Capabilities == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Capabilities, e)
+				} // end block: if x.Capabilities slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`logged_in`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.LoggedIn))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`message`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Message))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`protocol_version`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ProtocolVersion))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`quota`)
+				z.EncWriteMapElemValue()
+				yy24 := &x.Quota
+				if yyxt25 := z.Extension(yy24); yyxt25 != nil {
+					z.EncExtension(yy24, yyxt25)
+				} else {
+					yy24.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`usage`)
+				z.EncWriteMapElemValue()
+				yy26 := &x.Usage
+				if yyxt27 := z.Extension(yy26); yyxt27 != nil {
+					z.EncExtension(yy26, yyxt27)
+				} else {
+					yy26.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`user`)
+				z.EncWriteMapElemValue()
+				yy28 := &x.User
+				if yyxt29 := z.Extension(yy28); yyxt29 != nil {
+					z.EncExtension(yy28, yyxt29)
+				} else {
+					yy28.CodecEncodeSelf(e)
+				}
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`logged_in`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.LoggedIn))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`message`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Message))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`user`)
+				z.EncWriteMapElemValue()
+				yy32 := &x.User
+				if yyxt33 := z.Extension(yy32); yyxt33 != nil {
+					z.EncExtension(yy32, yyxt33)
+				} else {
+					yy32.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`quota`)
+				z.EncWriteMapElemValue()
+				yy34 := &x.Quota
+				if yyxt35 := z.Extension(yy34); yyxt35 != nil {
+					z.EncExtension(yy34, yyxt35)
+				} else {
+					yy34.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`usage`)
+				z.EncWriteMapElemValue()
+				yy36 := &x.Usage
+				if yyxt37 := z.Extension(yy36); yyxt37 != nil {
+					z.EncExtension(yy36, yyxt37)
+				} else {
+					yy36.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`protocol_version`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ProtocolVersion))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`capabilities`)
+				z.EncWriteMapElemValue()
+				if x.Capabilities == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Capabilities, e)
+				} // end block: if x.Capabilities slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *Welcome) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = Welcome{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *Welcome) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "logged_in":
+			x.LoggedIn = (bool)(r.DecodeBool())
+		case "message":
+			x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "user":
+			if yyxt7 := z.Extension(x.User); yyxt7 != nil {
+				z.DecExtension(&x.User, yyxt7)
+			} else {
+				x.User.CodecDecodeSelf(d)
+			}
+		case "quota":
+			if yyxt9 := z.Extension(x.Quota); yyxt9 != nil {
+				z.DecExtension(&x.Quota, yyxt9)
+			} else {
+				x.Quota.CodecDecodeSelf(d)
+			}
+		case "usage":
+			if yyxt11 := z.Extension(x.Usage); yyxt11 != nil {
+				z.DecExtension(&x.Usage, yyxt11)
+			} else {
+				x.Usage.CodecDecodeSelf(d)
+			}
+		case "protocol_version":
+			x.ProtocolVersion = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "capabilities":
+			z.F.DecSliceStringX(&x.Capabilities, d)
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *Welcome) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj15 int
+	var yyb15 bool
+	var yyhl15 bool = l >= 0
+	yyb15 = !z.DecContainerNext(yyj15, l, yyhl15)
+	if yyb15 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.LoggedIn = (bool)(r.DecodeBool())
+	yyj15++
+	yyb15 = !z.DecContainerNext(yyj15, l, yyhl15)
+	if yyb15 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj15++
+	yyb15 = !z.DecContainerNext(yyj15, l, yyhl15)
+	if yyb15 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt19 := z.Extension(x.User); yyxt19 != nil {
+		z.DecExtension(&x.User, yyxt19)
+	} else {
+		x.User.CodecDecodeSelf(d)
+	}
+	yyj15++
+	yyb15 = !z.DecContainerNext(yyj15, l, yyhl15)
+	if yyb15 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt21 := z.Extension(x.Quota); yyxt21 != nil {
+		z.DecExtension(&x.Quota, yyxt21)
+	} else {
+		x.Quota.CodecDecodeSelf(d)
+	}
+	yyj15++
+	yyb15 = !z.DecContainerNext(yyj15, l, yyhl15)
+	if yyb15 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt23 := z.Extension(x.Usage); yyxt23 != nil {
+		z.DecExtension(&x.Usage, yyxt23)
+	} else {
+		x.Usage.CodecDecodeSelf(d)
+	}
+	yyj15++
+	yyb15 = !z.DecContainerNext(yyj15, l, yyhl15)
+	if yyb15 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.ProtocolVersion = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj15++
+	yyb15 = !z.DecContainerNext(yyj15, l, yyhl15)
+	if yyb15 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecSliceStringX(&x.Capabilities, d)
+	yyj15++
+	for ; z.DecContainerNext(yyj15, l, yyhl15); yyj15++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj15-1, "")
+	}
+}
+
+func (x *Welcome) IsCodecEmpty() bool {
+	return !(bool(x.LoggedIn) || x.Message != "" || !(x.User.IsCodecEmpty()) || !(x.Quota.IsCodecEmpty()) || !(x.Usage.IsCodecEmpty()) || x.ProtocolVersion != 0 || len(x.Capabilities) != 0 || false)
+}
+
+func (ListFeedsRequest) codecSelferViaCodecgen() {}
+func (x *ListFeedsRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(5)
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Sort))
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Descending))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Filter))
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.PageSize))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Continuation))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(5)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`descending`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Descending))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`filter`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Filter))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`page_size`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.PageSize))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`sort`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Sort))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`sort`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Sort))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`descending`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Descending))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`filter`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Filter))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`page_size`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.PageSize))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *ListFeedsRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = ListFeedsRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *ListFeedsRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "sort":
+			x.Sort = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+		case "descending":
+			x.Descending = (bool)(r.DecodeBool())
+		case "filter":
+			x.Filter = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "page_size":
+			x.PageSize = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "continuation":
+			x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *ListFeedsRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj9 int
+	var yyb9 bool
+	var yyhl9 bool = l >= 0
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Sort = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Descending = (bool)(r.DecodeBool())
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Filter = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.PageSize = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj9++
+	for ; z.DecContainerNext(yyj9, l, yyhl9); yyj9++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj9-1, "")
+	}
+}
+
+func (x *ListFeedsRequest) IsCodecEmpty() bool {
+	return !(x.Sort != 0 || bool(x.Descending) || x.Filter != "" || x.PageSize != 0 || x.Continuation != "" || false)
+}
+
+func (ListFeedsResponse) codecSelferViaCodecgen() {}
+func (x *ListFeedsResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(3)
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Count))
+			z.EncWriteArrayElem()
+			if x.Feeds == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceFeed(([]Feed)(x.Feeds), e)
+			} // end block: if x.Feeds slice == nil
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Continuation))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(3)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feeds`)
+				z.EncWriteMapElemValue()
+				if x.Feeds == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceFeed(([]Feed)(x.Feeds), e)
+				} // end block: if x.Feeds slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feeds`)
+				z.EncWriteMapElemValue()
+				if x.Feeds == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceFeed(([]Feed)(x.Feeds), e)
+				} // end block: if x.Feeds slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *ListFeedsResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = ListFeedsResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *ListFeedsResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "count":
+			x.Count = (uint64)(r.DecodeUint64())
+		case "feeds":
+			h.decSliceFeed((*[]Feed)(&x.Feeds), d)
+		case "continuation":
+			x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *ListFeedsResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj8 int
+	var yyb8 bool
+	var yyhl8 bool = l >= 0
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Count = (uint64)(r.DecodeUint64())
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceFeed((*[]Feed)(&x.Feeds), d)
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj8++
+	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj8-1, "")
+	}
+}
+
+func (x *ListFeedsResponse) IsCodecEmpty() bool {
+	return !(x.Count != 0 || len(x.Feeds) != 0 || x.Continuation != "" || false)
+}
+
+func (ListItemsRequest) codecSelferViaCodecgen() {}
+func (x *ListItemsRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(5)
+			z.EncWriteArrayElem()
+			yy8 := &x.FeedID
+			if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+				z.EncExtension(yy8, yyxt9)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy8)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy8[:]), e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.UnreadOnly))
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.StarredOnly))
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.PageSize))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Continuation))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(5)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy15 := &x.FeedID
+				if yyxt16 := z.Extension(yy15); yyxt16 != nil {
+					z.EncExtension(yy15, yyxt16)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy15)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy15[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`page_size`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.PageSize))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`starred_only`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.StarredOnly))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`unread_only`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.UnreadOnly))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy20 := &x.FeedID
+				if yyxt21 := z.Extension(yy20); yyxt21 != nil {
+					z.EncExtension(yy20, yyxt21)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy20)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy20[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`unread_only`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.UnreadOnly))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`starred_only`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.StarredOnly))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`page_size`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.PageSize))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *ListItemsRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = ListItemsRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *ListItemsRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "feed_id":
+			if yyxt5 := z.Extension(x.FeedID); yyxt5 != nil {
+				z.DecExtension(&x.FeedID, yyxt5)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FeedID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+			}
+		case "unread_only":
+			x.UnreadOnly = (bool)(r.DecodeBool())
+		case "starred_only":
+			x.StarredOnly = (bool)(r.DecodeBool())
+		case "page_size":
+			x.PageSize = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "continuation":
+			x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *ListItemsRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj10 int
+	var yyb10 bool
+	var yyhl10 bool = l >= 0
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt12 := z.Extension(x.FeedID); yyxt12 != nil {
+		z.DecExtension(&x.FeedID, yyxt12)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FeedID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+	}
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.UnreadOnly = (bool)(r.DecodeBool())
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.StarredOnly = (bool)(r.DecodeBool())
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.PageSize = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj10++
+	for ; z.DecContainerNext(yyj10, l, yyhl10); yyj10++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj10-1, "")
+	}
+}
+
+func (x *ListItemsRequest) IsCodecEmpty() bool {
+	return !(x.FeedID != pkg1_primitive.ObjectID{} || bool(x.UnreadOnly) || bool(x.StarredOnly) || x.PageSize != 0 || x.Continuation != "" || false)
+}
+
+func (ListItemsResponse) codecSelferViaCodecgen() {}
+func (x *ListItemsResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(3)
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Count))
+			z.EncWriteArrayElem()
+			if x.Items == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceItem(([]Item)(x.Items), e)
+			} // end block: if x.Items slice == nil
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Continuation))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(3)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`items`)
+				z.EncWriteMapElemValue()
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceItem(([]Item)(x.Items), e)
+				} // end block: if x.Items slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`items`)
+				z.EncWriteMapElemValue()
+				if x.Items == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceItem(([]Item)(x.Items), e)
+				} // end block: if x.Items slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *ListItemsResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = ListItemsResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *ListItemsResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "count":
+			x.Count = (uint64)(r.DecodeUint64())
+		case "items":
+			h.decSliceItem((*[]Item)(&x.Items), d)
+		case "continuation":
+			x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *ListItemsResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj8 int
+	var yyb8 bool
+	var yyhl8 bool = l >= 0
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Count = (uint64)(r.DecodeUint64())
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceItem((*[]Item)(&x.Items), d)
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj8++
+	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj8-1, "")
+	}
+}
+
+func (x *ListItemsResponse) IsCodecEmpty() bool {
+	return !(x.Count != 0 || len(x.Items) != 0 || x.Continuation != "" || false)
+}
+
+func (MarkItemsRequest) codecSelferViaCodecgen() {}
+func (x *MarkItemsRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(4)
+			z.EncWriteArrayElem()
+			if x.IDs == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.IDs), e)
+			} // end block: if x.IDs slice == nil
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.All))
+			z.EncWriteArrayElem()
+			yy9 := &x.FeedID
+			if yyxt10 := z.Extension(yy9); yyxt10 != nil {
+				z.EncExtension(yy9, yyxt10)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy9)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy9[:]), e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Read))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(4)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`all`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.All))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy13 := &x.FeedID
+				if yyxt14 := z.Extension(yy13); yyxt14 != nil {
+					z.EncExtension(yy13, yyxt14)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy13)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy13[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ids`)
+				z.EncWriteMapElemValue()
+				if x.IDs == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.IDs), e)
+				} // end block: if x.IDs slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`read`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Read))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ids`)
+				z.EncWriteMapElemValue()
+				if x.IDs == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.IDs), e)
+				} // end block: if x.IDs slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`all`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.All))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy19 := &x.FeedID
+				if yyxt20 := z.Extension(yy19); yyxt20 != nil {
+					z.EncExtension(yy19, yyxt20)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy19)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy19[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`read`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Read))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *MarkItemsRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = MarkItemsRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *MarkItemsRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "ids":
+			h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.IDs), d)
+		case "all":
+			x.All = (bool)(r.DecodeBool())
+		case "feed_id":
+			if yyxt8 := z.Extension(x.FeedID); yyxt8 != nil {
+				z.DecExtension(&x.FeedID, yyxt8)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FeedID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+			}
+		case "read":
+			x.Read = (bool)(r.DecodeBool())
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *MarkItemsRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj10 int
+	var yyb10 bool
+	var yyhl10 bool = l >= 0
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.IDs), d)
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.All = (bool)(r.DecodeBool())
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt15 := z.Extension(x.FeedID); yyxt15 != nil {
+		z.DecExtension(&x.FeedID, yyxt15)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FeedID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+	}
+	yyj10++
+	yyb10 = !z.DecContainerNext(yyj10, l, yyhl10)
+	if yyb10 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Read = (bool)(r.DecodeBool())
+	yyj10++
+	for ; z.DecContainerNext(yyj10, l, yyhl10); yyj10++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj10-1, "")
+	}
+}
+
+func (x *MarkItemsRequest) IsCodecEmpty() bool {
+	return !(len(x.IDs) != 0 || bool(x.All) || x.FeedID != pkg1_primitive.ObjectID{} || bool(x.Read) || false)
+}
+
+func (StarItemsRequest) codecSelferViaCodecgen() {}
+func (x *StarItemsRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			if x.IDs == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.IDs), e)
+			} // end block: if x.IDs slice == nil
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Starred))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ids`)
+				z.EncWriteMapElemValue()
+				if x.IDs == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.IDs), e)
+				} // end block: if x.IDs slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`starred`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Starred))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ids`)
+				z.EncWriteMapElemValue()
+				if x.IDs == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceprimitive_ObjectID(([]pkg1_primitive.ObjectID)(x.IDs), e)
+				} // end block: if x.IDs slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`starred`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Starred))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *StarItemsRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = StarItemsRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *StarItemsRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "ids":
+			h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.IDs), d)
+		case "starred":
+			x.Starred = (bool)(r.DecodeBool())
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *StarItemsRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceprimitive_ObjectID((*[]pkg1_primitive.ObjectID)(&x.IDs), d)
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Starred = (bool)(r.DecodeBool())
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *StarItemsRequest) IsCodecEmpty() bool {
+	return !(len(x.IDs) != 0 || bool(x.Starred) || false)
+}
+
+func (UpdatedItemsResponse) codecSelferViaCodecgen() {}
+func (x *UpdatedItemsResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.ModifiedCount))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`modified_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ModifiedCount))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`modified_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ModifiedCount))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *UpdatedItemsResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = UpdatedItemsResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *UpdatedItemsResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "modified_count":
+			x.ModifiedCount = (uint64)(r.DecodeUint64())
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *UpdatedItemsResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
+	if yyb5 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.ModifiedCount = (uint64)(r.DecodeUint64())
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj5-1, "")
+	}
+}
+
+func (x *UpdatedItemsResponse) IsCodecEmpty() bool {
+	return !(x.ModifiedCount != 0 || false)
+}
+
+func (SearchItemsRequest) codecSelferViaCodecgen() {}
+func (x *SearchItemsRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(6)
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Query))
+			z.EncWriteArrayElem()
+			yy10 := &x.FeedID
+			if yyxt11 := z.Extension(yy10); yyxt11 != nil {
+				z.EncExtension(yy10, yyxt11)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy10)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy10[:]), e)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.Since)
+			} else if yyxt12 := z.Extension(x.Since); yyxt12 != nil {
+				z.EncExtension(x.Since, yyxt12)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.Since)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.Since)
+			} else {
+				z.EncFallback(x.Since)
+			}
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.Until)
+			} else if yyxt13 := z.Extension(x.Until); yyxt13 != nil {
+				z.EncExtension(x.Until, yyxt13)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.Until)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.Until)
+			} else {
+				z.EncFallback(x.Until)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.PageSize))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Continuation))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(6)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy17 := &x.FeedID
+				if yyxt18 := z.Extension(yy17); yyxt18 != nil {
+					z.EncExtension(yy17, yyxt18)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy17)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy17[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`page_size`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.PageSize))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`query`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Query))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`since`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Since)
+				} else if yyxt21 := z.Extension(x.Since); yyxt21 != nil {
+					z.EncExtension(x.Since, yyxt21)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Since)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Since)
+				} else {
+					z.EncFallback(x.Since)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`until`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Until)
+				} else if yyxt22 := z.Extension(x.Until); yyxt22 != nil {
+					z.EncExtension(x.Until, yyxt22)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Until)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Until)
+				} else {
+					z.EncFallback(x.Until)
+				}
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`query`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Query))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy24 := &x.FeedID
+				if yyxt25 := z.Extension(yy24); yyxt25 != nil {
+					z.EncExtension(yy24, yyxt25)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy24)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy24[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`since`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Since)
+				} else if yyxt26 := z.Extension(x.Since); yyxt26 != nil {
+					z.EncExtension(x.Since, yyxt26)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Since)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Since)
+				} else {
+					z.EncFallback(x.Since)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`until`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Until)
+				} else if yyxt27 := z.Extension(x.Until); yyxt27 != nil {
+					z.EncExtension(x.Until, yyxt27)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Until)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Until)
+				} else {
+					z.EncFallback(x.Until)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`page_size`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.PageSize))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *SearchItemsRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = SearchItemsRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *SearchItemsRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "query":
+			x.Query = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "feed_id":
+			if yyxt6 := z.Extension(x.FeedID); yyxt6 != nil {
+				z.DecExtension(&x.FeedID, yyxt6)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FeedID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+			}
+		case "since":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.Since = r.DecodeTime()
+			} else if yyxt8 := z.Extension(x.Since); yyxt8 != nil {
+				z.DecExtension(&x.Since, yyxt8)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.Since)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Since)
+			} else {
+				z.DecFallback(&x.Since, false)
+			}
+		case "until":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.Until = r.DecodeTime()
+			} else if yyxt10 := z.Extension(x.Until); yyxt10 != nil {
+				z.DecExtension(&x.Until, yyxt10)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.Until)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Until)
+			} else {
+				z.DecFallback(&x.Until, false)
+			}
+		case "page_size":
+			x.PageSize = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "continuation":
+			x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *SearchItemsRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj13 int
+	var yyb13 bool
+	var yyhl13 bool = l >= 0
+	yyb13 = !z.DecContainerNext(yyj13, l, yyhl13)
+	if yyb13 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Query = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj13++
+	yyb13 = !z.DecContainerNext(yyj13, l, yyhl13)
+	if yyb13 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt16 := z.Extension(x.FeedID); yyxt16 != nil {
+		z.DecExtension(&x.FeedID, yyxt16)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FeedID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+	}
+	yyj13++
+	yyb13 = !z.DecContainerNext(yyj13, l, yyhl13)
+	if yyb13 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.Since = r.DecodeTime()
+	} else if yyxt18 := z.Extension(x.Since); yyxt18 != nil {
+		z.DecExtension(&x.Since, yyxt18)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.Since)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Since)
+	} else {
+		z.DecFallback(&x.Since, false)
+	}
+	yyj13++
+	yyb13 = !z.DecContainerNext(yyj13, l, yyhl13)
+	if yyb13 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.Until = r.DecodeTime()
+	} else if yyxt20 := z.Extension(x.Until); yyxt20 != nil {
+		z.DecExtension(&x.Until, yyxt20)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.Until)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Until)
+	} else {
+		z.DecFallback(&x.Until, false)
+	}
+	yyj13++
+	yyb13 = !z.DecContainerNext(yyj13, l, yyhl13)
+	if yyb13 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.PageSize = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj13++
+	yyb13 = !z.DecContainerNext(yyj13, l, yyhl13)
+	if yyb13 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj13++
+	for ; z.DecContainerNext(yyj13, l, yyhl13); yyj13++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj13-1, "")
+	}
+}
+
+func (x *SearchItemsRequest) IsCodecEmpty() bool {
+	return !(x.Query != "" || x.FeedID != pkg1_primitive.ObjectID{} || !(x.Since.IsZero()) || !(x.Until.IsZero()) || x.PageSize != 0 || x.Continuation != "" || false)
+}
+
+func (SearchResult) codecSelferViaCodecgen() {}
+func (x *SearchResult) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(3)
+			z.EncWriteArrayElem()
+			yy6 := &x.Item
+			if yyxt7 := z.Extension(yy6); yyxt7 != nil {
+				z.EncExtension(yy6, yyxt7)
+			} else {
+				yy6.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeFloat64(float64(x.Score))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Snippet))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(3)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`item`)
+				z.EncWriteMapElemValue()
+				yy10 := &x.Item
+				if yyxt11 := z.Extension(yy10); yyxt11 != nil {
+					z.EncExtension(yy10, yyxt11)
+				} else {
+					yy10.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`score`)
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.Score))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`snippet`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Snippet))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`item`)
+				z.EncWriteMapElemValue()
+				yy14 := &x.Item
+				if yyxt15 := z.Extension(yy14); yyxt15 != nil {
+					z.EncExtension(yy14, yyxt15)
+				} else {
+					yy14.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`score`)
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.Score))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`snippet`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Snippet))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *SearchResult) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = SearchResult{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *SearchResult) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "item":
+			if yyxt5 := z.Extension(x.Item); yyxt5 != nil {
+				z.DecExtension(&x.Item, yyxt5)
+			} else {
+				x.Item.CodecDecodeSelf(d)
+			}
+		case "score":
+			x.Score = (float64)(r.DecodeFloat64())
+		case "snippet":
+			x.Snippet = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *SearchResult) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj8 int
+	var yyb8 bool
+	var yyhl8 bool = l >= 0
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt10 := z.Extension(x.Item); yyxt10 != nil {
+		z.DecExtension(&x.Item, yyxt10)
+	} else {
+		x.Item.CodecDecodeSelf(d)
+	}
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Score = (float64)(r.DecodeFloat64())
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Snippet = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj8++
+	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj8-1, "")
+	}
+}
+
+func (x *SearchResult) IsCodecEmpty() bool {
+	return !(!(x.Item.IsCodecEmpty()) || x.Score != 0 || x.Snippet != "" || false)
+}
+
+func (SearchItemsResponse) codecSelferViaCodecgen() {}
+func (x *SearchItemsResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(3)
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Count))
+			z.EncWriteArrayElem()
+			if x.Results == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceSearchResult(([]SearchResult)(x.Results), e)
+			} // end block: if x.Results slice == nil
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Continuation))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(3)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`results`)
+				z.EncWriteMapElemValue()
+				if x.Results == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceSearchResult(([]SearchResult)(x.Results), e)
+				} // end block: if x.Results slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`results`)
+				z.EncWriteMapElemValue()
+				if x.Results == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceSearchResult(([]SearchResult)(x.Results), e)
+				} // end block: if x.Results slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`continuation`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Continuation))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *SearchItemsResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = SearchItemsResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *SearchItemsResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "count":
+			x.Count = (uint64)(r.DecodeUint64())
+		case "results":
+			h.decSliceSearchResult((*[]SearchResult)(&x.Results), d)
+		case "continuation":
+			x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *SearchItemsResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj8 int
+	var yyb8 bool
+	var yyhl8 bool = l >= 0
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Count = (uint64)(r.DecodeUint64())
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceSearchResult((*[]SearchResult)(&x.Results), d)
+	yyj8++
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
+	if yyb8 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Continuation = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj8++
+	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj8-1, "")
+	}
+}
+
+func (x *SearchItemsResponse) IsCodecEmpty() bool {
+	return !(x.Count != 0 || len(x.Results) != 0 || x.Continuation != "" || false)
+}
+
+func (DeleteFeedRequest) codecSelferViaCodecgen() {}
+func (x *DeleteFeedRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			yy4 := &x.ID
+			if yyxt5 := z.Extension(yy4); yyxt5 != nil {
+				z.EncExtension(yy4, yyxt5)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy4)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy4[:]), e)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy6 := &x.ID
+				if yyxt7 := z.Extension(yy6); yyxt7 != nil {
+					z.EncExtension(yy6, yyxt7)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy6)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy6[:]), e)
+				}
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy8 := &x.ID
+				if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+					z.EncExtension(yy8, yyxt9)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy8)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy8[:]), e)
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *DeleteFeedRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = DeleteFeedRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *DeleteFeedRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "id":
+			if yyxt5 := z.Extension(x.ID); yyxt5 != nil {
+				z.DecExtension(&x.ID, yyxt5)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *DeleteFeedRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt8 := z.Extension(x.ID); yyxt8 != nil {
+		z.DecExtension(&x.ID, yyxt8)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	}
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+}
+
+func (x *DeleteFeedRequest) IsCodecEmpty() bool {
+	return !(x.ID != pkg1_primitive.ObjectID{} || false)
+}
+
+func (DeleteFeedResponse) codecSelferViaCodecgen() {}
+func (x *DeleteFeedResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			r.EncodeInt(int64(x.DeletedCount))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`deleted_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.DeletedCount))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`deleted_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.DeletedCount))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *DeleteFeedResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = DeleteFeedResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *DeleteFeedResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "deleted_count":
+			x.DeletedCount = (int64)(r.DecodeInt64())
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *DeleteFeedResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
+	if yyb5 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.DeletedCount = (int64)(r.DecodeInt64())
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj5-1, "")
+	}
+}
+
+func (x *DeleteFeedResponse) IsCodecEmpty() bool {
+	return !(x.DeletedCount != 0 || false)
+}
+
+func (GenericIDResponse) codecSelferViaCodecgen() {}
+func (x *GenericIDResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.OK))
+			z.EncWriteArrayElem()
+			yy6 := &x.ID
+			if yyxt7 := z.Extension(yy6); yyxt7 != nil {
+				z.EncExtension(yy6, yyxt7)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy6)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy6[:]), e)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy8 := &x.ID
+				if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+					z.EncExtension(yy8, yyxt9)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy8)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy8[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ok`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.OK))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ok`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.OK))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy12 := &x.ID
+				if yyxt13 := z.Extension(yy12); yyxt13 != nil {
+					z.EncExtension(yy12, yyxt13)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy12)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy12[:]), e)
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *GenericIDResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = GenericIDResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *GenericIDResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "ok":
+			x.OK = (bool)(r.DecodeBool())
+		case "id":
+			if yyxt6 := z.Extension(x.ID); yyxt6 != nil {
+				z.DecExtension(&x.ID, yyxt6)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *GenericIDResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.OK = (bool)(r.DecodeBool())
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt10 := z.Extension(x.ID); yyxt10 != nil {
+		z.DecExtension(&x.ID, yyxt10)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	}
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *GenericIDResponse) IsCodecEmpty() bool {
+	return !(bool(x.OK) || x.ID != pkg1_primitive.ObjectID{} || false)
+}
+
+func (UpdatedFeedResponse) codecSelferViaCodecgen() {}
+func (x *UpdatedFeedResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.ModifiedCount))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`modified_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ModifiedCount))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`modified_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.ModifiedCount))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *UpdatedFeedResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = UpdatedFeedResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *UpdatedFeedResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "modified_count":
+			x.ModifiedCount = (uint64)(r.DecodeUint64())
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *UpdatedFeedResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
+	if yyb5 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.ModifiedCount = (uint64)(r.DecodeUint64())
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj5-1, "")
+	}
+}
+
+func (x *UpdatedFeedResponse) IsCodecEmpty() bool {
+	return !(x.ModifiedCount != 0 || false)
+}
+
+func (VerifyEmailRequest) codecSelferViaCodecgen() {}
+func (x *VerifyEmailRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			yy4 := &x.Token
+			h.encArray32uint8((*[32]uint8)(yy4), e)
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`token`)
+				z.EncWriteMapElemValue()
+				yy6 := &x.Token
+				h.encArray32uint8((*[32]uint8)(yy6), e)
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`token`)
+				z.EncWriteMapElemValue()
+				yy8 := &x.Token
+				h.encArray32uint8((*[32]uint8)(yy8), e)
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *VerifyEmailRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = VerifyEmailRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *VerifyEmailRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "token":
+			h.decArray32uint8((*[32]uint8)(&x.Token), d)
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *VerifyEmailRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decArray32uint8((*[32]uint8)(&x.Token), d)
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+}
+
+func (x *VerifyEmailRequest) IsCodecEmpty() bool {
+	return !(x.Token != [32]uint8{} || false)
+}
+
+func (DeleteAccountRequest) codecSelferViaCodecgen() {}
+func (x *DeleteAccountRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			if x.Signature == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Signature))
+			} // end block: if x.Signature slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`signature`)
+				z.EncWriteMapElemValue()
+				if x.Signature == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Signature))
+				} // end block: if x.Signature slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`signature`)
+				z.EncWriteMapElemValue()
+				if x.Signature == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Signature))
+				} // end block: if x.Signature slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *DeleteAccountRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = DeleteAccountRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *DeleteAccountRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "signature":
+			x.Signature = z.DecodeBytesInto(([]byte)(x.Signature))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *DeleteAccountRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Signature = z.DecodeBytesInto(([]byte)(x.Signature))
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+}
+
+func (x *DeleteAccountRequest) IsCodecEmpty() bool {
+	return !(len(x.Signature) != 0 || false)
+}
+
+func (DeleteAccountResponse) codecSelferViaCodecgen() {}
+func (x *DeleteAccountResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Deleted))
+			z.EncWriteArrayElem()
+			if x.Challenge == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Challenge))
+			} // end block: if x.Challenge slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`challenge`)
+				z.EncWriteMapElemValue()
+				if x.Challenge == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Challenge))
+				} // end block: if x.Challenge slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`deleted`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Deleted))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`deleted`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Deleted))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`challenge`)
+				z.EncWriteMapElemValue()
+				if x.Challenge == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Challenge))
+				} // end block: if x.Challenge slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *DeleteAccountResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = DeleteAccountResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *DeleteAccountResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "deleted":
+			x.Deleted = (bool)(r.DecodeBool())
+		case "challenge":
+			x.Challenge = z.DecodeBytesInto(([]byte)(x.Challenge))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *DeleteAccountResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Deleted = (bool)(r.DecodeBool())
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Challenge = z.DecodeBytesInto(([]byte)(x.Challenge))
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *DeleteAccountResponse) IsCodecEmpty() bool {
+	return !(bool(x.Deleted) || len(x.Challenge) != 0 || false)
+}
+
+func (ExportDataResponse) codecSelferViaCodecgen() {}
+func (x *ExportDataResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.FileName))
+			z.EncWriteArrayElem()
+			if x.Archive == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Archive))
+			} // end block: if x.Archive slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`archive`)
+				z.EncWriteMapElemValue()
+				if x.Archive == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Archive))
+				} // end block: if x.Archive slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`file_name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.FileName))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`file_name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.FileName))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`archive`)
+				z.EncWriteMapElemValue()
+				if x.Archive == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Archive))
+				} // end block: if x.Archive slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *ExportDataResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = ExportDataResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *ExportDataResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "file_name":
+			x.FileName = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "archive":
+			x.Archive = z.DecodeBytesInto(([]byte)(x.Archive))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *ExportDataResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.FileName = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Archive = z.DecodeBytesInto(([]byte)(x.Archive))
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *ExportDataResponse) IsCodecEmpty() bool {
+	return !(x.FileName != "" || len(x.Archive) != 0 || false)
+}
+
+func (SessionTokenResponse) codecSelferViaCodecgen() {}
+func (x *SessionTokenResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Token))
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.ExpiresAt)
+			} else if yyxt6 := z.Extension(x.ExpiresAt); yyxt6 != nil {
+				z.EncExtension(x.ExpiresAt, yyxt6)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.ExpiresAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.ExpiresAt)
+			} else {
+				z.EncFallback(x.ExpiresAt)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`expires_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt7 := z.Extension(x.ExpiresAt); yyxt7 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt7)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`token`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Token))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`token`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Token))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`expires_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt10 := z.Extension(x.ExpiresAt); yyxt10 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt10)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *SessionTokenResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = SessionTokenResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *SessionTokenResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "token":
+			x.Token = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "expires_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.ExpiresAt = r.DecodeTime()
+			} else if yyxt6 := z.Extension(x.ExpiresAt); yyxt6 != nil {
+				z.DecExtension(&x.ExpiresAt, yyxt6)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.ExpiresAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ExpiresAt)
+			} else {
+				z.DecFallback(&x.ExpiresAt, false)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *SessionTokenResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Token = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.ExpiresAt = r.DecodeTime()
+	} else if yyxt10 := z.Extension(x.ExpiresAt); yyxt10 != nil {
+		z.DecExtension(&x.ExpiresAt, yyxt10)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.ExpiresAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ExpiresAt)
+	} else {
+		z.DecFallback(&x.ExpiresAt, false)
+	}
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *SessionTokenResponse) IsCodecEmpty() bool {
+	return !(x.Token != "" || !(x.ExpiresAt.IsZero()) || false)
+}
+
+func (CreateAPIKeyRequest) codecSelferViaCodecgen() {}
+func (x *CreateAPIKeyRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(3)
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Name))
+			z.EncWriteArrayElem()
+			if x.Scopes == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(x.Scopes, e)
+			} // end block: if x.Scopes slice == nil
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.ExpiresAt)
+			} else if yyxt8 := z.Extension(x.ExpiresAt); yyxt8 != nil {
+				z.EncExtension(x.ExpiresAt, yyxt8)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.ExpiresAt)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.ExpiresAt)
+			} else {
+				z.EncFallback(x.ExpiresAt)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(3)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`expires_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt9 := z.Extension(x.ExpiresAt); yyxt9 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt9)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Name))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`scopes`)
+				z.EncWriteMapElemValue()
+				if x.Scopes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Scopes, e)
+				} // end block: if x.Scopes slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`name`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Name))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`scopes`)
+				z.EncWriteMapElemValue()
+				if x.Scopes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Scopes, e)
+				} // end block: if x.Scopes slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`expires_at`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.ExpiresAt)
+				} else if yyxt14 := z.Extension(x.ExpiresAt); yyxt14 != nil {
+					z.EncExtension(x.ExpiresAt, yyxt14)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.ExpiresAt)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.ExpiresAt)
+				} else {
+					z.EncFallback(x.ExpiresAt)
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *CreateAPIKeyRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = CreateAPIKeyRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *CreateAPIKeyRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "name":
+			x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "scopes":
+			z.F.DecSliceStringX(&x.Scopes, d)
+		case "expires_at":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.ExpiresAt = r.DecodeTime()
+			} else if yyxt8 := z.Extension(x.ExpiresAt); yyxt8 != nil {
+				z.DecExtension(&x.ExpiresAt, yyxt8)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.ExpiresAt)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ExpiresAt)
+			} else {
+				z.DecFallback(&x.ExpiresAt, false)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *CreateAPIKeyRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj9 int
+	var yyb9 bool
+	var yyhl9 bool = l >= 0
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Name = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecSliceStringX(&x.Scopes, d)
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.ExpiresAt = r.DecodeTime()
+	} else if yyxt14 := z.Extension(x.ExpiresAt); yyxt14 != nil {
+		z.DecExtension(&x.ExpiresAt, yyxt14)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.ExpiresAt)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ExpiresAt)
+	} else {
+		z.DecFallback(&x.ExpiresAt, false)
+	}
+	yyj9++
+	for ; z.DecContainerNext(yyj9, l, yyhl9); yyj9++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj9-1, "")
+	}
+}
+
+func (x *CreateAPIKeyRequest) IsCodecEmpty() bool {
+	return !(x.Name != "" || len(x.Scopes) != 0 || !(x.ExpiresAt.IsZero()) || false)
+}
+
+func (CreateAPIKeyResponse) codecSelferViaCodecgen() {}
+func (x *CreateAPIKeyResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			yy5 := &x.Key
+			if yyxt6 := z.Extension(yy5); yyxt6 != nil {
+				z.EncExtension(yy5, yyxt6)
+			} else {
+				yy5.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Token))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`key`)
+				z.EncWriteMapElemValue()
+				yy8 := &x.Key
+				if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+					z.EncExtension(yy8, yyxt9)
+				} else {
+					yy8.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`token`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Token))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`key`)
+				z.EncWriteMapElemValue()
+				yy11 := &x.Key
+				if yyxt12 := z.Extension(yy11); yyxt12 != nil {
+					z.EncExtension(yy11, yyxt12)
+				} else {
+					yy11.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`token`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Token))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *CreateAPIKeyResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = CreateAPIKeyResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *CreateAPIKeyResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "key":
+			if yyxt5 := z.Extension(x.Key); yyxt5 != nil {
+				z.DecExtension(&x.Key, yyxt5)
+			} else {
+				x.Key.CodecDecodeSelf(d)
+			}
+		case "token":
+			x.Token = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *CreateAPIKeyResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt9 := z.Extension(x.Key); yyxt9 != nil {
+		z.DecExtension(&x.Key, yyxt9)
+	} else {
+		x.Key.CodecDecodeSelf(d)
+	}
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Token = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *CreateAPIKeyResponse) IsCodecEmpty() bool {
+	return !(!(x.Key.IsCodecEmpty()) || x.Token != "" || false)
+}
+
+func (ListAPIKeysResponse) codecSelferViaCodecgen() {}
+func (x *ListAPIKeysResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			if x.Keys == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceAPIKey(([]APIKey)(x.Keys), e)
+			} // end block: if x.Keys slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`keys`)
+				z.EncWriteMapElemValue()
+				if x.Keys == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceAPIKey(([]APIKey)(x.Keys), e)
+				} // end block: if x.Keys slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`keys`)
+				z.EncWriteMapElemValue()
+				if x.Keys == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceAPIKey(([]APIKey)(x.Keys), e)
+				} // end block: if x.Keys slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *ListAPIKeysResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = ListAPIKeysResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *ListAPIKeysResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "keys":
+			h.decSliceAPIKey((*[]APIKey)(&x.Keys), d)
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *ListAPIKeysResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceAPIKey((*[]APIKey)(&x.Keys), d)
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+}
+
+func (x *ListAPIKeysResponse) IsCodecEmpty() bool {
+	return !(len(x.Keys) != 0 || false)
+}
+
+func (RevokeAPIKeyRequest) codecSelferViaCodecgen() {}
+func (x *RevokeAPIKeyRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			yy4 := &x.ID
+			if yyxt5 := z.Extension(yy4); yyxt5 != nil {
+				z.EncExtension(yy4, yyxt5)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy4)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy4[:]), e)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy6 := &x.ID
+				if yyxt7 := z.Extension(yy6); yyxt7 != nil {
+					z.EncExtension(yy6, yyxt7)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy6)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy6[:]), e)
+				}
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`id`)
+				z.EncWriteMapElemValue()
+				yy8 := &x.ID
+				if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+					z.EncExtension(yy8, yyxt9)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy8)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy8[:]), e)
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *RevokeAPIKeyRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = RevokeAPIKeyRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *RevokeAPIKeyRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "id":
+			if yyxt5 := z.Extension(x.ID); yyxt5 != nil {
+				z.DecExtension(&x.ID, yyxt5)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.ID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *RevokeAPIKeyRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt8 := z.Extension(x.ID); yyxt8 != nil {
+		z.DecExtension(&x.ID, yyxt8)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.ID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.ID[:]), d)
+	}
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+}
+
+func (x *RevokeAPIKeyRequest) IsCodecEmpty() bool {
+	return !(x.ID != pkg1_primitive.ObjectID{} || false)
+}
+
+func (RevokeAPIKeyResponse) codecSelferViaCodecgen() {}
+func (x *RevokeAPIKeyResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			r.EncodeInt(int64(x.DeletedCount))
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`deleted_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.DeletedCount))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`deleted_count`)
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.DeletedCount))
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *RevokeAPIKeyResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = RevokeAPIKeyResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *RevokeAPIKeyResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "deleted_count":
+			x.DeletedCount = (int64)(r.DecodeInt64())
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *RevokeAPIKeyResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
+	if yyb5 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.DeletedCount = (int64)(r.DecodeInt64())
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj5-1, "")
+	}
+}
+
+func (x *RevokeAPIKeyResponse) IsCodecEmpty() bool {
+	return !(x.DeletedCount != 0 || false)
+}
+
+func (SupportedRequestsResponse) codecSelferViaCodecgen() {}
+func (x *SupportedRequestsResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(1)
+			z.EncWriteArrayElem()
+			if x.RequestIDs == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceuint32(([]uint32)(x.RequestIDs), e)
+			} // end block: if x.RequestIDs slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(1)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`request_ids`)
+				z.EncWriteMapElemValue()
+				if x.RequestIDs == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceuint32(([]uint32)(x.RequestIDs), e)
+				} // end block: if x.RequestIDs slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`request_ids`)
+				z.EncWriteMapElemValue()
+				if x.RequestIDs == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceuint32(([]uint32)(x.RequestIDs), e)
+				} // end block: if x.RequestIDs slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *SupportedRequestsResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = SupportedRequestsResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *SupportedRequestsResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "request_ids":
+			h.decSliceuint32((*[]uint32)(&x.RequestIDs), d)
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *SupportedRequestsResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
+	if yyb6 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceuint32((*[]uint32)(&x.RequestIDs), d)
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+}
+
+func (x *SupportedRequestsResponse) IsCodecEmpty() bool {
+	return !(len(x.RequestIDs) != 0 || false)
+}
+
+func (BatchItem) codecSelferViaCodecgen() {}
+func (x *BatchItem) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.RequestID))
+			z.EncWriteArrayElem()
+			if x.Payload == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Payload))
+			} // end block: if x.Payload slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`payload`)
+				z.EncWriteMapElemValue()
+				if x.Payload == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Payload))
+				} // end block: if x.Payload slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`request_id`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.RequestID))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`request_id`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.RequestID))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`payload`)
+				z.EncWriteMapElemValue()
+				if x.Payload == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Payload))
+				} // end block: if x.Payload slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *BatchItem) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = BatchItem{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *BatchItem) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "request_id":
+			x.RequestID = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		case "payload":
+			x.Payload = z.DecodeBytesInto(([]byte)(x.Payload))
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *BatchItem) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.RequestID = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Payload = z.DecodeBytesInto(([]byte)(x.Payload))
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *BatchItem) IsCodecEmpty() bool {
+	return !(x.RequestID != 0 || len(x.Payload) != 0 || false)
+}
+
+func (BatchRequest) codecSelferViaCodecgen() {}
+func (x *BatchRequest) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Atomic))
+			z.EncWriteArrayElem()
+			if x.Requests == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceBatchItem(([]BatchItem)(x.Requests), e)
+			} // end block: if x.Requests slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`atomic`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Atomic))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`requests`)
+				z.EncWriteMapElemValue()
+				if x.Requests == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceBatchItem(([]BatchItem)(x.Requests), e)
+				} // end block: if x.Requests slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`atomic`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Atomic))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`requests`)
+				z.EncWriteMapElemValue()
+				if x.Requests == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceBatchItem(([]BatchItem)(x.Requests), e)
+				} // end block: if x.Requests slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *BatchRequest) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = BatchRequest{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *BatchRequest) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "atomic":
+			x.Atomic = (bool)(r.DecodeBool())
+		case "requests":
+			h.decSliceBatchItem((*[]BatchItem)(&x.Requests), d)
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *BatchRequest) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Atomic = (bool)(r.DecodeBool())
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceBatchItem((*[]BatchItem)(&x.Requests), d)
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *BatchRequest) IsCodecEmpty() bool {
+	return !(bool(x.Atomic) || len(x.Requests) != 0 || false)
+}
+
+func (BatchResult) codecSelferViaCodecgen() {}
+func (x *BatchResult) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(3)
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.OK))
+			z.EncWriteArrayElem()
+			if x.Payload == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.Payload))
+			} // end block: if x.Payload slice == nil
+			z.EncWriteArrayElem()
+			yy8 := &x.Error
+			if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+				z.EncExtension(yy8, yyxt9)
+			} else {
+				yy8.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(3)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`error`)
+				z.EncWriteMapElemValue()
+				yy10 := &x.Error
+				if yyxt11 := z.Extension(yy10); yyxt11 != nil {
+					z.EncExtension(yy10, yyxt11)
+				} else {
+					yy10.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ok`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.OK))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`payload`)
+				z.EncWriteMapElemValue()
+				if x.Payload == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Payload))
+				} // end block: if x.Payload slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`ok`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.OK))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`payload`)
+				z.EncWriteMapElemValue()
+				if x.Payload == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Payload))
+				} // end block: if x.Payload slice == nil
+				z.EncWriteMapElemKey()
+				r.EncodeString(`error`)
+				z.EncWriteMapElemValue()
+				yy16 := &x.Error
+				if yyxt17 := z.Extension(yy16); yyxt17 != nil {
+					z.EncExtension(yy16, yyxt17)
+				} else {
+					yy16.CodecEncodeSelf(e)
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *BatchResult) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = BatchResult{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *BatchResult) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "ok":
+			x.OK = (bool)(r.DecodeBool())
+		case "payload":
+			x.Payload = z.DecodeBytesInto(([]byte)(x.Payload))
+		case "error":
+			if yyxt8 := z.Extension(x.Error); yyxt8 != nil {
+				z.DecExtension(&x.Error, yyxt8)
+			} else {
+				x.Error.CodecDecodeSelf(d)
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *BatchResult) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj9 int
+	var yyb9 bool
+	var yyhl9 bool = l >= 0
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.OK = (bool)(r.DecodeBool())
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Payload = z.DecodeBytesInto(([]byte)(x.Payload))
+	yyj9++
+	yyb9 = !z.DecContainerNext(yyj9, l, yyhl9)
+	if yyb9 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt14 := z.Extension(x.Error); yyxt14 != nil {
+		z.DecExtension(&x.Error, yyxt14)
+	} else {
+		x.Error.CodecDecodeSelf(d)
+	}
+	yyj9++
+	for ; z.DecContainerNext(yyj9, l, yyhl9); yyj9++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj9-1, "")
+	}
+}
+
+func (x *BatchResult) IsCodecEmpty() bool {
+	return !(bool(x.OK) || len(x.Payload) != 0 || !(x.Error.IsCodecEmpty()) || false)
+}
+
+func (BatchResponse) codecSelferViaCodecgen() {}
+func (x *BatchResponse) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeBool(bool(x.Committed))
+			z.EncWriteArrayElem()
+			if x.Results == nil {
+				r.EncodeNil()
+			} else {
+				h.encSliceBatchResult(([]BatchResult)(x.Results), e)
+			} // end block: if x.Results slice == nil
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`committed`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Committed))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`results`)
+				z.EncWriteMapElemValue()
+				if x.Results == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceBatchResult(([]BatchResult)(x.Results), e)
+				} // end block: if x.Results slice == nil
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`committed`)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.Committed))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`results`)
+				z.EncWriteMapElemValue()
+				if x.Results == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceBatchResult(([]BatchResult)(x.Results), e)
+				} // end block: if x.Results slice == nil
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *BatchResponse) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = BatchResponse{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *BatchResponse) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "committed":
+			x.Committed = (bool)(r.DecodeBool())
+		case "results":
+			h.decSliceBatchResult((*[]BatchResult)(&x.Results), d)
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *BatchResponse) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Committed = (bool)(r.DecodeBool())
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decSliceBatchResult((*[]BatchResult)(&x.Results), d)
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *BatchResponse) IsCodecEmpty() bool {
+	return !(bool(x.Committed) || len(x.Results) != 0 || false)
+}
+
+func (EventType) codecSelferViaCodecgen() {}
+func (x EventType) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	r.EncodeUint(uint64(x))
+}
+
+func (x *EventType) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	*x = (EventType)(z.C.UintV(r.DecodeUint64(), 8))
+}
+
+func (Event) codecSelferViaCodecgen() {}
+func (x *Event) CodecEncodeSelf(e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(6)
+			z.EncWriteArrayElem()
+			if yyxt9 := z.Extension(x.Type); yyxt9 != nil {
+				z.EncExtension(x.Type, yyxt9)
+			} else {
+				x.Type.CodecEncodeSelf(e)
+			}
+			z.EncWriteArrayElem()
+			yy10 := &x.FeedID
+			if yyxt11 := z.Extension(yy10); yyxt11 != nil {
+				z.EncExtension(yy10, yyxt11)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(*yy10)
+			} else {
+				z.F.EncSliceUint8V(([]uint8)(yy10[:]), e)
+			}
+			z.EncWriteArrayElem()
+			r.EncodeUint(uint64(x.Count))
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.Message))
+			z.EncWriteArrayElem()
+			if z.EncBasicHandle().TimeBuiltin() {
+				r.EncodeTime(x.Timestamp)
+			} else if yyxt14 := z.Extension(x.Timestamp); yyxt14 != nil {
+				z.EncExtension(x.Timestamp, yyxt14)
+			} else if z.EncBinary() {
+				z.EncBinaryMarshal(x.Timestamp)
+			} else if !z.EncBinary() && z.IsJSONHandle() {
+				z.EncJSONMarshal(x.Timestamp)
+			} else {
+				z.EncFallback(x.Timestamp)
+			}
+			z.EncWriteArrayElem()
+			if yyxt15 := z.Extension(x.ErrorCode); yyxt15 != nil {
+				z.EncExtension(x.ErrorCode, yyxt15)
+			} else {
+				r.EncodeUint(uint64(x.ErrorCode))
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(6)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`error_code`)
+				z.EncWriteMapElemValue()
+				if yyxt17 := z.Extension(x.ErrorCode); yyxt17 != nil {
+					z.EncExtension(x.ErrorCode, yyxt17)
+				} else {
+					r.EncodeUint(uint64(x.ErrorCode))
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy18 := &x.FeedID
+				if yyxt19 := z.Extension(yy18); yyxt19 != nil {
+					z.EncExtension(yy18, yyxt19)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy18)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy18[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`message`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Message))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`timestamp`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Timestamp)
+				} else if yyxt21 := z.Extension(x.Timestamp); yyxt21 != nil {
+					z.EncExtension(x.Timestamp, yyxt21)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Timestamp)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Timestamp)
+				} else {
+					z.EncFallback(x.Timestamp)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`type`)
+				z.EncWriteMapElemValue()
+				if yyxt22 := z.Extension(x.Type); yyxt22 != nil {
+					z.EncExtension(x.Type, yyxt22)
+				} else {
+					x.Type.CodecEncodeSelf(e)
+				}
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeString(`type`)
+				z.EncWriteMapElemValue()
+				if yyxt23 := z.Extension(x.Type); yyxt23 != nil {
+					z.EncExtension(x.Type, yyxt23)
+				} else {
+					x.Type.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`feed_id`)
+				z.EncWriteMapElemValue()
+				yy24 := &x.FeedID
+				if yyxt25 := z.Extension(yy24); yyxt25 != nil {
+					z.EncExtension(yy24, yyxt25)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(*yy24)
+				} else {
+					z.F.EncSliceUint8V(([]uint8)(yy24[:]), e)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`count`)
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Count))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`message`)
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Message))
+				z.EncWriteMapElemKey()
+				r.EncodeString(`timestamp`)
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Timestamp)
+				} else if yyxt28 := z.Extension(x.Timestamp); yyxt28 != nil {
+					z.EncExtension(x.Timestamp, yyxt28)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Timestamp)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Timestamp)
+				} else {
+					z.EncFallback(x.Timestamp)
+				}
+				z.EncWriteMapElemKey()
+				r.EncodeString(`error_code`)
+				z.EncWriteMapElemValue()
+				if yyxt29 := z.Extension(x.ErrorCode); yyxt29 != nil {
+					z.EncExtension(x.ErrorCode, yyxt29)
+				} else {
+					r.EncodeUint(uint64(x.ErrorCode))
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *Event) CodecDecodeSelf(d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil42 {
+		*(x) = Event{}
+	} else if yyct2 == codecSelferValueTypeMap42 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray42 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct42)
+	}
+}
+
+func (x *Event) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "type":
+			if yyxt5 := z.Extension(x.Type); yyxt5 != nil {
+				z.DecExtension(&x.Type, yyxt5)
+			} else {
+				x.Type.CodecDecodeSelf(d)
+			}
+		case "feed_id":
+			if yyxt7 := z.Extension(x.FeedID); yyxt7 != nil {
+				z.DecExtension(&x.FeedID, yyxt7)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.FeedID)
+			} else {
+				z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+			}
+		case "count":
+			x.Count = (uint64)(r.DecodeUint64())
+		case "message":
+			x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "timestamp":
+			if z.DecBasicHandle().TimeBuiltin() {
+				x.Timestamp = r.DecodeTime()
+			} else if yyxt11 := z.Extension(x.Timestamp); yyxt11 != nil {
+				z.DecExtension(&x.Timestamp, yyxt11)
+			} else if z.DecBinary() {
+				z.DecBinaryUnmarshal(&x.Timestamp)
+			} else if !z.DecBinary() && z.IsJSONHandle() {
+				z.DecJSONUnmarshal(&x.Timestamp)
+			} else {
+				z.DecFallback(&x.Timestamp, false)
+			}
+		case "error_code":
+			if yyxt13 := z.Extension(x.ErrorCode); yyxt13 != nil {
+				z.DecExtension(&x.ErrorCode, yyxt13)
+			} else {
+				x.ErrorCode = (ErrorCode)(r.DecodeUint64())
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *Event) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj14 int
+	var yyb14 bool
+	var yyhl14 bool = l >= 0
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
+	if yyb14 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt16 := z.Extension(x.Type); yyxt16 != nil {
+		z.DecExtension(&x.Type, yyxt16)
+	} else {
+		x.Type.CodecDecodeSelf(d)
+	}
+	yyj14++
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
+	if yyb14 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt18 := z.Extension(x.FeedID); yyxt18 != nil {
+		z.DecExtension(&x.FeedID, yyxt18)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.FeedID)
+	} else {
+		z.F.DecSliceUint8N(([]uint8)(x.FeedID[:]), d)
+	}
+	yyj14++
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
+	if yyb14 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Count = (uint64)(r.DecodeUint64())
+	yyj14++
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
+	if yyb14 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Message = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj14++
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
+	if yyb14 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if z.DecBasicHandle().TimeBuiltin() {
+		x.Timestamp = r.DecodeTime()
+	} else if yyxt22 := z.Extension(x.Timestamp); yyxt22 != nil {
+		z.DecExtension(&x.Timestamp, yyxt22)
+	} else if z.DecBinary() {
+		z.DecBinaryUnmarshal(&x.Timestamp)
+	} else if !z.DecBinary() && z.IsJSONHandle() {
+		z.DecJSONUnmarshal(&x.Timestamp)
+	} else {
+		z.DecFallback(&x.Timestamp, false)
+	}
+	yyj14++
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
+	if yyb14 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt24 := z.Extension(x.ErrorCode); yyxt24 != nil {
+		z.DecExtension(&x.ErrorCode, yyxt24)
+	} else {
+		x.ErrorCode = (ErrorCode)(r.DecodeUint64())
+	}
+	yyj14++
+	for ; z.DecContainerNext(yyj14, l, yyhl14); yyj14++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj14-1, "")
+	}
+}
+
+func (x *Event) IsCodecEmpty() bool {
+	return !(x.Type != 0 || x.FeedID != pkg1_primitive.ObjectID{} || x.Count != 0 || x.Message != "" || !(x.Timestamp.IsZero()) || x.ErrorCode != 0 || false)
+}
+
+func (x codecSelfer42) encArray20uint8(v *[20]uint8, e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	r.EncodeStringBytesRaw(((*[20]byte)(v))[:])
+}
+
+func (x codecSelfer42) decArray20uint8(v *[20]uint8, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	r.DecodeBytes(((*[20]byte)(v))[:])
+}
+
+func (x codecSelfer42) encArray32uint8(v *[32]uint8, e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	r.EncodeStringBytesRaw(((*[32]byte)(v))[:])
+}
+
+func (x codecSelfer42) decArray32uint8(v *[32]uint8, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	r.DecodeBytes(((*[32]byte)(v))[:])
+}
+
+func (x codecSelfer42) encSliceprimitive_ObjectID(v []pkg1_primitive.ObjectID, e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		yy2 := &v[yyv1]
+		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+			z.EncExtension(yy2, yyxt3)
+		} else if !z.EncBinary() && z.IsJSONHandle() {
+			z.EncJSONMarshal(*yy2)
+		} else {
+			z.F.EncSliceUint8V(([]uint8)(yy2[:]), e)
+		}
+	}
+	z.EncWriteArrayEnd()
+}
+
+func (x codecSelfer42) decSliceprimitive_ObjectID(v *[]pkg1_primitive.ObjectID, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []pkg1_primitive.ObjectID{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 12)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]pkg1_primitive.ObjectID, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 12)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]pkg1_primitive.ObjectID, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, pkg1_primitive.ObjectID{})
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if yyxt3 := z.Extension(yyv1[yyj1]); yyxt3 != nil {
+					z.DecExtension(&yyv1[yyj1], yyxt3)
+				} else if !z.DecBinary() && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(&yyv1[yyj1])
+				} else {
+					z.F.DecSliceUint8N(([]uint8)(yyv1[yyj1][:]), d)
+				}
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []pkg1_primitive.ObjectID{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
+}
+
+func (x codecSelfer42) encSliceEnclosure(v []Enclosure, e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		yy2 := &v[yyv1]
+		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+			z.EncExtension(yy2, yyxt3)
+		} else {
+			yy2.CodecEncodeSelf(e)
+		}
+	}
+	z.EncWriteArrayEnd()
+}
+
+func (x codecSelfer42) decSliceEnclosure(v *[]Enclosure, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []Enclosure{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 40)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]Enclosure, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 40)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]Enclosure, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, Enclosure{})
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if yyxt3 := z.Extension(yyv1[yyj1]); yyxt3 != nil {
+					z.DecExtension(&yyv1[yyj1], yyxt3)
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []Enclosure{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
+}
+
+func (x codecSelfer42) encSliceFeed(v []Feed, e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		yy2 := &v[yyv1]
+		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+			z.EncExtension(yy2, yyxt3)
+		} else {
+			yy2.CodecEncodeSelf(e)
+		}
+	}
+	z.EncWriteArrayEnd()
+}
+
+func (x codecSelfer42) decSliceFeed(v *[]Feed, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []Feed{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 248)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]Feed, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 248)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]Feed, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, Feed{})
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if yyxt3 := z.Extension(yyv1[yyj1]); yyxt3 != nil {
+					z.DecExtension(&yyv1[yyj1], yyxt3)
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []Feed{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
+}
+
+func (x codecSelfer42) encSliceItem(v []Item, e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		yy2 := &v[yyv1]
+		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+			z.EncExtension(yy2, yyxt3)
+		} else {
+			yy2.CodecEncodeSelf(e)
+		}
+	}
+	z.EncWriteArrayEnd()
+}
+
+func (x codecSelfer42) decSliceItem(v *[]Item, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []Item{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 264)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]Item, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 264)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]Item, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, Item{})
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if yyxt3 := z.Extension(yyv1[yyj1]); yyxt3 != nil {
+					z.DecExtension(&yyv1[yyj1], yyxt3)
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []Item{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
+}
+
+func (x codecSelfer42) encSliceSearchResult(v []SearchResult, e *codec1978.Encoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		yy2 := &v[yyv1]
+		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+			z.EncExtension(yy2, yyxt3)
+		} else {
+			yy2.CodecEncodeSelf(e)
+		}
+	}
+	z.EncWriteArrayEnd()
+}
+
+func (x codecSelfer42) decSliceSearchResult(v *[]SearchResult, d *codec1978.Decoder) {
+	var h codecSelfer42
+	z, r := codec1978.GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []SearchResult{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 288)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]SearchResult, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 288)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]SearchResult, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, SearchResult{})
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if yyxt3 := z.Extension(yyv1[yyj1]); yyxt3 != nil {
+					z.DecExtension(&yyv1[yyj1], yyxt3)
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []SearchResult{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
 	}
 }
 
-func (x *VerifyEmailRequest) IsCodecEmpty() bool {
-	return !(x.Token != [32]uint8{} || false)
-}
-
-func (x codecSelfer42) encArray20uint8(v *[20]uint8, e *codec1978.Encoder) {
+func (x codecSelfer42) encSliceAPIKey(v []APIKey, e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -2996,17 +10441,101 @@ func (x codecSelfer42) encArray20uint8(v *[20]uint8, e *codec1978.Encoder) {
 		r.EncodeNil()
 		return
 	}
-	r.EncodeStringBytesRaw(((*[20]byte)(v))[:])
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		yy2 := &v[yyv1]
+		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+			z.EncExtension(yy2, yyxt3)
+		} else {
+			yy2.CodecEncodeSelf(e)
+		}
+	}
+	z.EncWriteArrayEnd()
 }
 
-func (x codecSelfer42) decArray20uint8(v *[20]uint8, d *codec1978.Decoder) {
+func (x codecSelfer42) decSliceAPIKey(v *[]APIKey, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	r.DecodeBytes(((*[20]byte)(v))[:])
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []APIKey{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 184)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]APIKey, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 184)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]APIKey, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, APIKey{})
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if yyxt3 := z.Extension(yyv1[yyj1]); yyxt3 != nil {
+					z.DecExtension(&yyv1[yyj1], yyxt3)
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []APIKey{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
 }
 
-func (x codecSelfer42) encArray32uint8(v *[32]uint8, e *codec1978.Encoder) {
+func (x codecSelfer42) encSliceuint32(v []uint32, e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -3014,17 +10543,92 @@ func (x codecSelfer42) encArray32uint8(v *[32]uint8, e *codec1978.Encoder) {
 		r.EncodeNil()
 		return
 	}
-	r.EncodeStringBytesRaw(((*[32]byte)(v))[:])
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		r.EncodeUint(uint64(v[yyv1]))
+	}
+	z.EncWriteArrayEnd()
 }
 
-func (x codecSelfer42) decArray32uint8(v *[32]uint8, d *codec1978.Decoder) {
+func (x codecSelfer42) decSliceuint32(v *[]uint32, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	r.DecodeBytes(((*[32]byte)(v))[:])
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []uint32{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]uint32, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]uint32, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, 0)
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				yyv1[yyj1] = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []uint32{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
 }
 
-func (x codecSelfer42) encArray65uint8(v *[65]uint8, e *codec1978.Encoder) {
+func (x codecSelfer42) encSliceBatchItem(v []BatchItem, e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -3032,17 +10636,101 @@ func (x codecSelfer42) encArray65uint8(v *[65]uint8, e *codec1978.Encoder) {
 		r.EncodeNil()
 		return
 	}
-	r.EncodeStringBytesRaw(((*[65]byte)(v))[:])
+	z.EncWriteArrayStart(len(v))
+	for yyv1 := range v {
+		z.EncWriteArrayElem()
+		yy2 := &v[yyv1]
+		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+			z.EncExtension(yy2, yyxt3)
+		} else {
+			yy2.CodecEncodeSelf(e)
+		}
+	}
+	z.EncWriteArrayEnd()
 }
 
-func (x codecSelfer42) decArray65uint8(v *[65]uint8, d *codec1978.Decoder) {
+func (x codecSelfer42) decSliceBatchItem(v *[]BatchItem, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	r.DecodeBytes(((*[65]byte)(v))[:])
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []BatchItem{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 32)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]BatchItem, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
+		}
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 32)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]BatchItem, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, BatchItem{})
+				yyc1 = true
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if yyxt3 := z.Extension(yyv1[yyj1]); yyxt3 != nil {
+					z.DecExtension(&yyv1[yyj1], yyxt3)
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+			}
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = []BatchItem{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
 }
 
-func (x codecSelfer42) encSliceFeed(v []Feed, e *codec1978.Encoder) {
+func (x codecSelfer42) encSliceBatchResult(v []BatchResult, e *codec1978.Encoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -3063,7 +10751,7 @@ func (x codecSelfer42) encSliceFeed(v []Feed, e *codec1978.Encoder) {
 	z.EncWriteArrayEnd()
 }
 
-func (x codecSelfer42) decSliceFeed(v *[]Feed, d *codec1978.Decoder) {
+func (x codecSelfer42) decSliceBatchResult(v *[]BatchResult, d *codec1978.Decoder) {
 	var h codecSelfer42
 	z, r := codec1978.GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -3079,7 +10767,7 @@ func (x codecSelfer42) decSliceFeed(v *[]Feed, d *codec1978.Decoder) {
 		}
 	} else if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []Feed{}
+			yyv1 = []BatchResult{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -3091,11 +10779,11 @@ func (x codecSelfer42) decSliceFeed(v *[]Feed, d *codec1978.Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 136)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 104)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]Feed, yyrl1)
+					yyv1 = make([]BatchResult, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -3107,17 +10795,17 @@ func (x codecSelfer42) decSliceFeed(v *[]Feed, d *codec1978.Decoder) {
 		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 136)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 104)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]Feed, yyrl1)
+				yyv1 = make([]BatchResult, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, Feed{})
+				yyv1 = append(yyv1, BatchResult{})
 				yyc1 = true
 			}
 			if yydb1 {
@@ -3134,7 +10822,7 @@ func (x codecSelfer42) decSliceFeed(v *[]Feed, d *codec1978.Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = []Feed{}
+			yyv1 = []BatchResult{}
 			yyc1 = true
 		}
 	}
@@ -1,4 +1,5 @@
-//go:generate codecgen -o structures.generated.go -j=false -d=42 structures.go request_types.go
+//go:generate codecgen -o structures.generated.go -j=false -d=42 structures.go request_types.go events.go
+//go:generate go run ../tools/protogen -o ../protocol
 package structures
 
 import (
@@ -16,24 +17,160 @@ type User struct {
 	EmailVerified          bool               `codec:"email_verified" bson:"email_verified"`
 	EmailVerificationToken [32]byte           `codec:"email_verification_token" bson:"email_verification_token"`
 	EmailVerificationLast  time.Time          `codec:"email_verification_last" bson:"email_verification_last"`
+	// Zero fields fall back to the server's defaults
+	Quota Quota `codec:"quota" bson:"quota"`
+	// The UTC day EmailsToday counts for
+	EmailDay    time.Time `codec:"email_day" bson:"email_day"`
+	EmailsToday uint32    `codec:"emails_today" bson:"emails_today"`
+}
+
+type Quota struct {
+	MaxFeeds         uint32        `codec:"max_feeds" bson:"max_feeds"`
+	MinimumFrequency time.Duration `codec:"minimum_frequency" bson:"minimum_frequency"`
+	MaxEmailsPerDay  uint32        `codec:"max_emails_per_day" bson:"max_emails_per_day"`
+}
+
+type Usage struct {
+	Feeds       uint64 `codec:"feeds"`
+	EmailsToday uint32 `codec:"emails_today"`
 }
 
 type Feed struct {
-	CreatedAt   time.Time          `codec:"created_at" bson:"created_at"`
-	UpdatedAt   time.Time          `codec:"updated_at" bson:"updated_at"`
-	ID          primitive.ObjectID `codec:"id" bson:"_id"`
-	Owner       primitive.ObjectID `codec:"owner_id" bson:"owner_id"`
-	Name        string             `codec:"name" bson:"name"`
-	URL         string             `codec:"feed_url" bson:"feed_url"`
-	Frequency   time.Duration      `codec:"frequency" bson:"frequency"`
-	LastFetched time.Time          `codec:"last_fetched" bson:"last_fetched"`
+	CreatedAt    time.Time          `codec:"created_at" bson:"created_at"`
+	UpdatedAt    time.Time          `codec:"updated_at" bson:"updated_at"`
+	ID           primitive.ObjectID `codec:"id" bson:"_id"`
+	Owner        primitive.ObjectID `codec:"owner_id" bson:"owner_id"`
+	Name         string             `codec:"name" bson:"name"`
+	URL          string             `codec:"feed_url" bson:"feed_url"`
+	Frequency    time.Duration      `codec:"frequency" bson:"frequency"`
+	LastFetched  time.Time          `codec:"last_fetched" bson:"last_fetched"`
+	LastError    string             `codec:"last_error" bson:"last_error"`
+	FailingSince time.Time          `codec:"failing_since" bson:"failing_since"`
+	// The first successful fetch, whatever the feed had then is considered old
+	FirstFetched time.Time `codec:"first_fetched" bson:"first_fetched"`
+	// One of the Identity constants, how items are told apart
+	Identity uint8 `codec:"identity" bson:"identity"`
+	// Set when the identity changes, the next fetch only records the feed's items under their new keys
+	Rebaseline bool `codec:"-" bson:"rebaseline"`
+	// Whether already seen items that change get e-mailed again, with what changed
+	NotifyUpdates bool `codec:"notify_updates" bson:"notify_updates"`
+	// Whether the e-mails carry the article downloaded from the item's link rather than what the feed has
+	FullText bool `codec:"full_text" bson:"full_text"`
+	// Whether enclosures are attached to the e-mails, as far as the configured size limits allow
+	AttachEnclosures bool `codec:"attach_enclosures" bson:"attach_enclosures"`
+	// One of the Images constants, what's done with the images of the e-mails
+	Images uint8 `codec:"images" bson:"images"`
+	// One of the Delivery constants
+	Delivery uint8 `codec:"delivery" bson:"delivery"`
+	// Set for saved searches, which have no URL and match the new items of the owner's other feeds instead
+	Query string `codec:"query" bson:"query"`
+	// The feeds a saved search looks at, every feed of the owner if empty
+	SourceFeeds []primitive.ObjectID `codec:"source_feeds" bson:"source_feeds"`
 }
 
+// Delivery modes for feeds and saved searches
+const (
+	DeliveryEmail uint8 = 0x00
+	// Only archived and announced to connected clients
+	DeliveryNone uint8 = 0x01
+)
+
+// Image handling for feeds, anything but remote adds an HTML part to the e-mails that loads nothing from the feed's sites
+const (
+	// Left as they are, mail clients load them from wherever they're hosted
+	ImagesRemote uint8 = 0x00
+	// Downloaded and attached to the e-mails
+	ImagesInline uint8 = 0x01
+	// Loaded through the backend's signed image proxy
+	ImagesProxy uint8 = 0x02
+)
+
+// Identity strategies for feeds, whichever is chosen falls back to the next one for items where it's empty or not unique
+const (
+	IdentityGUID uint8 = 0x00
+	IdentityLink uint8 = 0x01
+	// Title, link and dates, for feeds that regenerate GUIDs and links
+	IdentityHash uint8 = 0x02
+)
+
 type SeenItem struct {
+	ID     primitive.ObjectID `codec:"id" bson:"_id"`
+	FeedID primitive.ObjectID `codec:"feed_id" bson:"feed_id"`
+	// Not necessarily the GUID, the key the feed's identity strategy picked
+	GUID      string    `codec:"guid" bson:"guid"`
+	Timestamp time.Time `codec:"timestamp" bson:"timestamp"`
+	// Hash of the title and text as last seen, empty for items seen before updates were tracked
+	ContentHash string `codec:"content_hash" bson:"content_hash"`
+	// The item's own updated date, if it has one
+	Updated time.Time `codec:"updated" bson:"updated"`
+	// Kept to diff against when the item changes
+	Title string `codec:"title" bson:"title"`
+	Text  string `codec:"text" bson:"text"`
+}
+
+// Item is the archived copy of a feed's item, kept for reading in the app
+type Item struct {
+	ID     primitive.ObjectID `codec:"id" bson:"_id"`
+	FeedID primitive.ObjectID `codec:"feed_id" bson:"feed_id"`
+	Owner  primitive.ObjectID `codec:"owner_id" bson:"owner_id"`
+	// The same key as the item's SeenItem
+	Key     string `codec:"key" bson:"key"`
+	Title   string `codec:"title" bson:"title"`
+	Link    string `codec:"link" bson:"link"`
+	Author  string `codec:"author" bson:"author"`
+	Content string `codec:"content" bson:"content"`
+	// Content without the markup, only for searching
+	Text       string      `codec:"-" bson:"text"`
+	Enclosures []Enclosure `codec:"enclosures" bson:"enclosures"`
+	// When it came out, the first time it was fetched for items that don't say
+	Published time.Time `codec:"published" bson:"published"`
+	Updated   time.Time `codec:"updated" bson:"updated"`
+	FetchedAt time.Time `codec:"fetched_at" bson:"fetched_at"`
+	Read      bool      `codec:"read" bson:"read"`
+	Starred   bool      `codec:"starred" bson:"starred"`
+	// The saved searches that matched the item
+	Searches []primitive.ObjectID `codec:"searches" bson:"searches"`
+}
+
+type Enclosure struct {
+	URL  string `codec:"url" bson:"url"`
+	Type string `codec:"type" bson:"type"`
+	// In bytes, zero when the feed doesn't say
+	Length uint64 `codec:"length" bson:"length"`
+}
+
+type Session struct {
+	ID        primitive.ObjectID `codec:"id" bson:"_id"`
+	UserID    primitive.ObjectID `codec:"user_id" bson:"user_id"`
+	TokenHash [32]byte           `codec:"-" bson:"token_hash"`
+	CreatedAt time.Time          `codec:"created_at" bson:"created_at"`
+	ExpiresAt time.Time          `codec:"expires_at" bson:"expires_at"`
+	// Those of the API key the issuing connection logged in with, nil if it signed in
+	Scopes []string `codec:"scopes" bson:"scopes"`
+	// The API key the issuing connection logged in with, revoking it ends the session too
+	KeyID primitive.ObjectID `codec:"key_id" bson:"key_id,omitempty"`
+}
+
+// Scopes restrict what an API key may be used for, and sessions issued to connections that logged in with one. Signature
+// logins aren't restricted.
+const (
+	ScopeFeedsRead  = "feeds:read"
+	ScopeFeedsWrite = "feeds:write"
+	ScopeAccount    = "account"
+	// Only the aggregated feed output, for keys that end up in the URLs other readers are given
+	ScopeOutput = "output"
+)
+
+type APIKey struct {
 	ID        primitive.ObjectID `codec:"id" bson:"_id"`
-	FeedID    primitive.ObjectID `codec:"feed_id" bson:"feed_id"`
-	GUID      string             `codec:"guid" bson:"guid"`
-	Timestamp time.Time          `codec:"timestamp" bson:"timestamp"`
+	UserID    primitive.ObjectID `codec:"user_id" bson:"user_id"`
+	Name      string             `codec:"name" bson:"name"`
+	Prefix    string             `codec:"prefix" bson:"prefix"`
+	Hash      [32]byte           `codec:"-" bson:"hash"`
+	Scopes    []string           `codec:"scopes" bson:"scopes"`
+	CreatedAt time.Time          `codec:"created_at" bson:"created_at"`
+	ExpiresAt time.Time          `codec:"expires_at" bson:"expires_at"`
+	LastUsed  time.Time          `codec:"last_used" bson:"last_used"`
 }
 
 // MessagePack-oriented structures
@@ -42,29 +179,49 @@ type ErrorMessage struct {
 
 	Code    ErrorCode
 	Message string
+	// Empty unless Code is ErrorValidation
+	Field string
+	// Set whenever details were logged, quoting it helps finding them
+	CorrelationID string
+	// In seconds, only set with ErrorRateLimited
+	RetryAfter uint32
 }
 
 type InitializationRequest struct {
 	Address [20]byte `codec:"address"`
 	Locale  string   `codec:"locale"`
+	// Optional, logs in with an API key instead of answering a signature challenge
+	APIKey string `codec:"api_key"`
+	// The version the client speaks, clients predating versioning don't send these and a zero version is treated as
+	// version 1
+	ProtocolVersion uint32   `codec:"protocol_version"`
+	Capabilities    []string `codec:"capabilities"`
 }
 
 type InitializationResponse struct {
-	UserFound bool   `codec:"user_found"`
-	Challenge []byte `codec:"challenge"`
+	UserFound       bool     `codec:"user_found"`
+	Challenge       []byte   `codec:"challenge"`
+	ProtocolVersion uint32   `codec:"protocol_version"`
+	Capabilities    []string `codec:"capabilities"`
 }
 
 type NewUserInitialization struct {
-	Email     string   `codec:"email"`
-	Signature [65]byte `codec:"signature"`
+	Email     string `codec:"email"`
+	Signature []byte `codec:"signature"`
 }
 
 type OrdinaryInitialization struct {
-	Signature [65]byte `codec:"signature"`
+	Signature []byte `codec:"signature"`
 }
 
 type Welcome struct {
 	LoggedIn bool   `codec:"logged_in"`
 	Message  string `codec:"message"`
 	User     User   `codec:"user"`
+	// The quota in effect, with the defaults filled in
+	Quota Quota `codec:"quota"`
+	Usage Usage `codec:"usage"`
+	// As negotiated, API key logins get no InitializationResponse to learn them from
+	ProtocolVersion uint32   `codec:"protocol_version"`
+	Capabilities    []string `codec:"capabilities"`
 }
@@ -0,0 +1,470 @@
+// protogen writes a JSON schema of the websocket protocol and a TypeScript client for it, both derived from the structures package
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"git.maharshi.ninja/root/rss2email/structures"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	objectIDType = reflect.TypeOf(primitive.ObjectID{})
+)
+
+type constantValue struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+	// The literal as written in Go, it's valid TypeScript as well
+	literal string
+}
+
+func main() {
+	out := flag.String("o", "protocol", "output directory")
+	src := flag.String("src", ".", "directory of the structures package, for the constants")
+	flag.Parse()
+
+	constants, err := readConstants(*src)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	schema, err := buildSchema(constants)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	client := buildClient(constants)
+
+	err = os.MkdirAll(*out, 0o755)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = os.WriteFile(filepath.Join(*out, "schema.json"), schema, 0o644)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = os.WriteFile(filepath.Join(*out, "client.ts"), client, 0o644)
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// readConstants collects every exported constant with a literal value, in source order
+func readConstants(dir string) ([]constantValue, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), ".generated.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs["structures"]
+	if !ok {
+		return nil, fmt.Errorf("no structures package in %s", dir)
+	}
+
+	fileNames := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	var constants []constantValue
+	for _, name := range fileNames {
+		for _, decl := range pkg.Files[name].Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, ident := range vs.Names {
+					if !ident.IsExported() || i >= len(vs.Values) {
+						continue
+					}
+					lit, ok := vs.Values[i].(*ast.BasicLit)
+					if !ok {
+						continue
+					}
+					v := constant.MakeFromLiteral(lit.Value, lit.Kind, 0)
+					var value interface{}
+					switch v.Kind() {
+					case constant.Int:
+						n, _ := constant.Uint64Val(v)
+						value = n
+					case constant.String:
+						value = constant.StringVal(v)
+					default:
+						continue
+					}
+					constants = append(constants, constantValue{Name: ident.Name, Value: value, literal: lit.Value})
+				}
+			}
+		}
+	}
+	return constants, nil
+}
+
+func buildSchema(constants []constantValue) ([]byte, error) {
+	const refPrefix = "#/$defs/"
+	defs := map[string]interface{}{}
+	schemaOf := func(v interface{}) interface{} {
+		if v == nil {
+			return nil
+		}
+		return structures.MsgpackSchema(reflect.TypeOf(v), defs, refPrefix)
+	}
+
+	requests := make([]map[string]interface{}, 0, len(structures.Requests))
+	for _, r := range structures.Requests {
+		requests = append(requests, map[string]interface{}{
+			"id":       r.ID,
+			"name":     r.Name,
+			"request":  schemaOf(r.Request),
+			"response": schemaOf(r.Response),
+		})
+	}
+	handshake := make([]interface{}, 0, len(structures.Handshake))
+	for _, m := range structures.Handshake {
+		handshake = append(handshake, schemaOf(m))
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"$schema":         "https://json-schema.org/draft/2020-12/schema",
+		"encoding":        "msgpack",
+		"protocolVersion": structures.ProtocolVersion,
+		"handshake":       handshake,
+		"requests":        requests,
+		"constants":       constants,
+		"$defs":           defs,
+	}, "", "  ")
+}
+
+// tsTypes turns reflect types into TypeScript, collecting the declarations of named types as it goes
+type tsTypes struct {
+	declared map[string]bool
+	decls    bytes.Buffer
+}
+
+func (g *tsTypes) typeOf(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return "Date"
+	case durationType:
+		return "number"
+	case objectIDType:
+		return "ObjectID"
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if t.PkgPath() != "" && t.Name() != "" {
+			g.alias(t.Name(), "number")
+			return t.Name()
+		}
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "Uint8Array"
+		}
+		return g.typeOf(t.Elem()) + "[]"
+	case reflect.Map:
+		return "Record<string, " + g.typeOf(t.Elem()) + ">"
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structBody(t)
+		}
+		if !g.declared[t.Name()] {
+			g.declared[t.Name()] = true
+			// Built before writing, the fields may declare types of their own
+			body := g.structBody(t)
+			if strings.HasPrefix(body, "[") {
+				fmt.Fprintf(&g.decls, "export type %s = %s;\n\n", t.Name(), body)
+			} else {
+				fmt.Fprintf(&g.decls, "export interface %s %s\n\n", t.Name(), body)
+			}
+		}
+		return t.Name()
+	}
+	return "unknown"
+}
+
+func (g *tsTypes) alias(name, typ string) {
+	if g.declared[name] {
+		return
+	}
+	g.declared[name] = true
+	fmt.Fprintf(&g.decls, "export type %s = %s;\n\n", name, typ)
+}
+
+// structBody follows the codec tags, toarray structs become tuples
+func (g *tsTypes) structBody(t reflect.Type) string {
+	toArray := false
+	if f, ok := t.FieldByName("_struct"); ok {
+		toArray = strings.Contains(f.Tag.Get("codec"), "toarray")
+	}
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("codec"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if toArray {
+			fields = append(fields, tsIdentifier(name)+": "+g.typeOf(f.Type))
+		} else {
+			fields = append(fields, "  "+name+": "+g.typeOf(f.Type)+";\n")
+		}
+	}
+
+	if toArray {
+		return "[" + strings.Join(fields, ", ") + "]"
+	}
+	return "{\n" + strings.Join(fields, "") + "}"
+}
+
+func tsIdentifier(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func buildClient(constants []constantValue) []byte {
+	g := &tsTypes{declared: map[string]bool{}}
+	for _, m := range structures.Handshake {
+		g.typeOf(reflect.TypeOf(m))
+	}
+	type method struct {
+		name, constName, requestType, responseType string
+		hasRequest                                 bool
+	}
+	methods := make([]method, 0, len(structures.Requests))
+	for _, r := range structures.Requests {
+		m := method{name: tsIdentifier(r.Name), constName: r.Name, responseType: g.typeOf(reflect.TypeOf(r.Response))}
+		if r.Request != nil {
+			m.hasRequest = true
+			m.requestType = g.typeOf(reflect.TypeOf(r.Request))
+		}
+		methods = append(methods, m)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(clientHeader)
+
+	for _, c := range constants {
+		fmt.Fprintf(&b, "export const %s = %s;\n", c.Name, c.literal)
+	}
+	b.WriteString("\n")
+	b.WriteString("// ObjectIDs travel as their 12 raw bytes\nexport type ObjectID = Uint8Array;\n\n")
+	b.Write(g.decls.Bytes())
+
+	b.WriteString(clientBody)
+
+	for _, m := range methods {
+		if m.hasRequest {
+			fmt.Fprintf(&b, "\n  %s(req: %s): Promise<%s> {\n    return this.request(Request%s, req);\n  }\n", m.name, m.requestType, m.responseType, m.constName)
+		} else {
+			fmt.Fprintf(&b, "\n  %s(): Promise<%s> {\n    return this.request(Request%s, null);\n  }\n", m.name, m.responseType, m.constName)
+		}
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+const clientHeader = `// Code generated by tools/protogen. DO NOT EDIT.
+
+import { decode, encode } from "@msgpack/msgpack";
+
+`
+
+const clientBody = `export class RequestError extends Error {
+  constructor(
+    readonly code: number,
+    message: string,
+    // The offending input for ErrorValidation
+    readonly field = "",
+    // Identifies the details in the server's log
+    readonly correlationID = "",
+    // In seconds, for ErrorRateLimited
+    readonly retryAfter = 0,
+  ) {
+    super(message);
+    this.name = "RequestError";
+  }
+
+  static from(msg: ErrorMessage): RequestError {
+    return new RequestError(...msg);
+  }
+}
+
+export type SignChallenge = (
+  challenge: Uint8Array,
+  userFound: boolean,
+) => Promise<NewUserInitialization | OrdinaryInitialization>;
+
+interface Pending {
+  resolve: (value: unknown) => void;
+  reject: (reason: unknown) => void;
+}
+
+// Client speaks the websocket protocol: requests go out as [ID u32 LE][RequestID u32 LE][msgpack body], responses
+// come back as [ID u32 LE][0xFF if OK][msgpack body]
+export class Client {
+  onEvent?: (event: Event) => void;
+  // Errors the server couldn't attribute to a message (ID 0), mostly bodies that failed to decode
+  onError?: (err: RequestError) => void;
+
+  private nextID = 1;
+  private pending = new Map<number, Pending>();
+  // During the handshake responses aren't matched by ID, they're handed out in order
+  private handshake: Pending[] | null = [];
+
+  constructor(private readonly ws: WebSocket) {
+    ws.binaryType = "arraybuffer";
+    ws.addEventListener("message", (ev) => this.receive(new Uint8Array(ev.data as ArrayBuffer)));
+    ws.addEventListener("close", () => {
+      const err = new RequestError(ErrorUnspecified, "connection closed");
+      this.handshake?.forEach((p) => p.reject(err));
+      this.pending.forEach((p) => p.reject(err));
+      this.pending.clear();
+    });
+  }
+
+  static connect(url: string): Promise<Client> {
+    return new Promise((resolve, reject) => {
+      const ws = new WebSocket(url);
+      ws.addEventListener("open", () => resolve(new Client(ws)), { once: true });
+      ws.addEventListener("error", reject, { once: true });
+    });
+  }
+
+  // login runs the handshake, sign is only called when logging in without an API key
+  async login(req: Partial<InitializationRequest>, sign?: SignChallenge): Promise<Welcome> {
+    this.send(this.nextID++, 0, { protocol_version: ProtocolVersion, ...req });
+    if (req.api_key) {
+      return this.finishHandshake(await this.nextHandshakeMessage());
+    }
+    const ir = (await this.nextHandshakeMessage()) as InitializationResponse;
+    if (!sign) {
+      throw new RequestError(ErrorInvalidInputs, "no way to sign the challenge");
+    }
+    this.send(this.nextID++, 0, await sign(ir.challenge, ir.user_found));
+    return this.finishHandshake(await this.nextHandshakeMessage());
+  }
+
+  close(): void {
+    this.ws.close();
+  }
+
+  // runBatch encodes the bodies for RequestBatch and decodes the results, failed items come back as RequestErrors
+  async runBatch(
+    items: [requestID: number, body: unknown][],
+    atomic = false,
+  ): Promise<{ committed: boolean; results: unknown[] }> {
+    const resp = await this.batch({
+      atomic,
+      requests: items.map(([request_id, body]) => ({ request_id, payload: encode(body) })),
+    });
+    return {
+      committed: resp.committed,
+      results: resp.results.map((r) => (r.ok ? decode(r.payload) : RequestError.from(r.error))),
+    };
+  }
+
+  private finishHandshake(msg: unknown): Welcome {
+    this.handshake = null;
+    return msg as Welcome;
+  }
+
+  private nextHandshakeMessage(): Promise<unknown> {
+    return new Promise((resolve, reject) => this.handshake?.push({ resolve, reject }));
+  }
+
+  private request<T>(requestID: number, body: unknown): Promise<T> {
+    const id = this.nextID++;
+    if (this.nextID >= EventMessageID) {
+      this.nextID = 1;
+    }
+    return new Promise<T>((resolve, reject) => {
+      this.pending.set(id, { resolve: resolve as (value: unknown) => void, reject });
+      this.send(id, requestID, body);
+    });
+  }
+
+  private send(id: number, requestID: number, body: unknown): void {
+    const encoded = encode(body);
+    const buf = new Uint8Array(8 + encoded.length);
+    const view = new DataView(buf.buffer);
+    view.setUint32(0, id, true);
+    view.setUint32(4, requestID, true);
+    buf.set(encoded, 8);
+    this.ws.send(buf);
+  }
+
+  private receive(buf: Uint8Array): void {
+    const view = new DataView(buf.buffer, buf.byteOffset, buf.byteLength);
+    const id = view.getUint32(0, true);
+    const ok = buf[4] === 0xff;
+    const body = decode(buf.subarray(5));
+
+    if (id === EventMessageID) {
+      this.onEvent?.(body as Event);
+      return;
+    }
+
+    let err: RequestError | undefined;
+    if (!ok) {
+      err = RequestError.from(body as ErrorMessage);
+    }
+
+    const handshake = this.handshake?.shift();
+    if (handshake) {
+      err ? handshake.reject(err) : handshake.resolve(body);
+      return;
+    }
+
+    const p = this.pending.get(id);
+    if (!p) {
+      if (err) {
+        this.onError?.(err);
+      }
+      return;
+    }
+    this.pending.delete(id);
+    err ? p.reject(err) : p.resolve(body);
+  }
+`